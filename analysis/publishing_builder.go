@@ -0,0 +1,97 @@
+package analysis
+
+import (
+	"time"
+
+	"github.com/thewizardplusplus/go-atari-montecarlo/builders"
+	"github.com/thewizardplusplus/go-atari-montecarlo/builders/terminators"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// Publisher ...
+type Publisher interface {
+	Publish(snapshot Snapshot)
+}
+
+// PublisherFunc ...
+type PublisherFunc func(snapshot Snapshot)
+
+// Publish ...
+func (publisher PublisherFunc) Publish(snapshot Snapshot) {
+	publisher(snapshot)
+}
+
+// PublishingBuilder ...
+//
+// It performs a pass of the builder and then publishes a snapshot
+// of the tree, if the interval has elapsed since the previous publication.
+// Snapshots are created and published in the goroutine of building,
+// so the publisher should be fast.
+//
+// It should be used by builders.IterativeBuilder on the root to be published
+// (e.g. not inside builders.ParallelBuilder, which builds copies of the root).
+//
+// It isn't safe for concurrent use.
+//
+type PublishingBuilder struct {
+	builder          builders.Builder
+	clock            terminators.Clock
+	interval         time.Duration
+	maximalMoveCount int
+	publisher        Publisher
+
+	pass            int
+	publicationTime time.Time
+}
+
+// NewPublishingBuilder ...
+//
+// See the function NewSnapshot() for the maximal move count.
+//
+func NewPublishingBuilder(
+	builder builders.Builder,
+	clock terminators.Clock,
+	interval time.Duration,
+	maximalMoveCount int,
+	publisher Publisher,
+) *PublishingBuilder {
+	return &PublishingBuilder{
+		builder:          builder,
+		clock:            clock,
+		interval:         interval,
+		maximalMoveCount: maximalMoveCount,
+		publisher:        publisher,
+		publicationTime:  clock(),
+	}
+}
+
+// Pass ...
+func (builder *PublishingBuilder) Pass(root *tree.Node) {
+	builder.builder.Pass(root)
+	builder.pass++
+
+	currentTime := builder.clock()
+	if currentTime.Sub(builder.publicationTime) >= builder.interval {
+		builder.publish(root, currentTime, false)
+	}
+}
+
+// PublishFinal ...
+//
+// It publishes a final snapshot of the tree regardless of the interval.
+//
+func (builder *PublishingBuilder) PublishFinal(root *tree.Node) {
+	builder.publish(root, builder.clock(), true)
+}
+
+func (builder *PublishingBuilder) publish(
+	root *tree.Node,
+	currentTime time.Time,
+	isFinal bool,
+) {
+	snapshot := NewSnapshot(root, builder.pass, builder.maximalMoveCount)
+	snapshot.IsFinal = isFinal
+	builder.publisher.Publish(snapshot)
+
+	builder.publicationTime = currentTime
+}
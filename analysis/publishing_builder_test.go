@@ -0,0 +1,89 @@
+package analysis
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+type MockBuilder struct {
+	pass func(root *tree.Node)
+}
+
+func (builder MockBuilder) Pass(root *tree.Node) {
+	if builder.pass == nil {
+		panic("not implemented")
+	}
+
+	builder.pass(root)
+}
+
+func TestPublishingBuilder(test *testing.T) {
+	type data struct {
+		interval      time.Duration
+		passCount     int
+		wantSnapshots []Snapshot
+	}
+
+	for _, data := range []data{
+		{
+			interval:  0,
+			passCount: 2,
+			wantSnapshots: []Snapshot{
+				{Pass: 1, GameCount: 1, Moves: []MoveStatistics{}},
+				{Pass: 2, GameCount: 2, Moves: []MoveStatistics{}},
+				{Pass: 2, GameCount: 2, Moves: []MoveStatistics{}, IsFinal: true},
+			},
+		},
+		{
+			interval:  2 * time.Second,
+			passCount: 5,
+			wantSnapshots: []Snapshot{
+				{Pass: 2, GameCount: 2, Moves: []MoveStatistics{}},
+				{Pass: 4, GameCount: 4, Moves: []MoveStatistics{}},
+				{Pass: 5, GameCount: 5, Moves: []MoveStatistics{}, IsFinal: true},
+			},
+		},
+		{
+			interval:  time.Minute,
+			passCount: 3,
+			wantSnapshots: []Snapshot{
+				{Pass: 3, GameCount: 3, Moves: []MoveStatistics{}, IsFinal: true},
+			},
+		},
+	} {
+		// the clock advances by a second on each call
+		var clockTime time.Time
+		clock := func() time.Time {
+			clockTime = clockTime.Add(time.Second)
+			return clockTime
+		}
+
+		var gotSnapshots []Snapshot
+		builder := NewPublishingBuilder(
+			MockBuilder{
+				pass: func(root *tree.Node) {
+					root.State.GameCount++
+				},
+			},
+			clock,
+			data.interval,
+			0,
+			PublisherFunc(func(snapshot Snapshot) {
+				gotSnapshots = append(gotSnapshots, snapshot)
+			}),
+		)
+
+		root := &tree.Node{}
+		for i := 0; i < data.passCount; i++ {
+			builder.Pass(root)
+		}
+		builder.PublishFinal(root)
+
+		if !reflect.DeepEqual(gotSnapshots, data.wantSnapshots) {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package analysis
+
+import (
+	"sort"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/selectors"
+	"github.com/thewizardplusplus/go-atari-montecarlo/selectors/scorers"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// MoveStatistics ...
+//
+// Its state is from the point of view of the player that makes the move.
+//
+type MoveStatistics struct {
+	Move  models.Move
+	State tree.NodeState
+}
+
+// Snapshot ...
+//
+// It describes a state of building of a tree. Moves are sorted by descending
+// game counts; the principal variation is selected by game counts too.
+//
+type Snapshot struct {
+	Pass               int
+	GameCount          int
+	Moves              []MoveStatistics
+	PrincipalVariation []models.Move
+	IsFinal            bool
+}
+
+// NewSnapshot ...
+//
+// If the maximal move count is zero, all moves are included.
+//
+func NewSnapshot(root *tree.Node, pass int, maximalMoveCount int) Snapshot {
+	children := append(tree.NodeGroup(nil), root.Children...)
+	sort.SliceStable(children, func(i int, j int) bool {
+		return children[i].State.GameCount > children[j].State.GameCount
+	})
+	if maximalMoveCount != 0 && len(children) > maximalMoveCount {
+		children = children[:maximalMoveCount]
+	}
+
+	moves := make([]MoveStatistics, 0, len(children))
+	for _, child := range children {
+		moves = append(moves, MoveStatistics{
+			Move:  child.Move,
+			State: child.State,
+		})
+	}
+
+	selector := selectors.MaximalNodeSelector{
+		NodeScorer: scorers.GameCountScorer{},
+	}
+	var principalVariation []models.Move
+	for _, node := range root.PrincipalVariation(selector) {
+		principalVariation = append(principalVariation, node.Move)
+	}
+
+	return Snapshot{
+		Pass:               pass,
+		GameCount:          root.State.GameCount,
+		Moves:              moves,
+		PrincipalVariation: principalVariation,
+	}
+}
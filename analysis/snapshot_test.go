@@ -0,0 +1,145 @@
+package analysis
+
+import (
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestNewSnapshot(test *testing.T) {
+	type args struct {
+		root             *tree.Node
+		pass             int
+		maximalMoveCount int
+	}
+	type data struct {
+		args args
+		want Snapshot
+	}
+
+	newRoot := func() *tree.Node {
+		return &tree.Node{
+			State: tree.NodeState{GameCount: 6, WinCount: 2},
+			Children: tree.NodeGroup{
+				&tree.Node{
+					Move: models.Move{
+						Color: models.White,
+						Point: models.Point{Column: 0, Row: 0},
+					},
+					State: tree.NodeState{GameCount: 1, WinCount: 1},
+				},
+				&tree.Node{
+					Move: models.Move{
+						Color: models.White,
+						Point: models.Point{Column: 1, Row: 0},
+					},
+					State: tree.NodeState{GameCount: 3, WinCount: 2},
+					Children: tree.NodeGroup{
+						&tree.Node{
+							Move: models.Move{
+								Color: models.Black,
+								Point: models.Point{Column: 0, Row: 0},
+							},
+							State: tree.NodeState{GameCount: 2, WinCount: 1},
+						},
+					},
+				},
+				&tree.Node{
+					Move: models.Move{
+						Color: models.White,
+						Point: models.Point{Column: 2, Row: 0},
+					},
+					State: tree.NodeState{GameCount: 2, WinCount: 0},
+				},
+			},
+		}
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				root:             &tree.Node{},
+				pass:             1,
+				maximalMoveCount: 0,
+			},
+			want: Snapshot{
+				Pass:  1,
+				Moves: []MoveStatistics{},
+			},
+		},
+		{
+			args: args{
+				root:             newRoot(),
+				pass:             5,
+				maximalMoveCount: 0,
+			},
+			want: Snapshot{
+				Pass:      5,
+				GameCount: 6,
+				Moves: []MoveStatistics{
+					{
+						Move: models.Move{
+							Color: models.White,
+							Point: models.Point{Column: 1, Row: 0},
+						},
+						State: tree.NodeState{GameCount: 3, WinCount: 2},
+					},
+					{
+						Move: models.Move{
+							Color: models.White,
+							Point: models.Point{Column: 2, Row: 0},
+						},
+						State: tree.NodeState{GameCount: 2, WinCount: 0},
+					},
+					{
+						Move: models.Move{
+							Color: models.White,
+							Point: models.Point{Column: 0, Row: 0},
+						},
+						State: tree.NodeState{GameCount: 1, WinCount: 1},
+					},
+				},
+				PrincipalVariation: []models.Move{
+					{Color: models.White, Point: models.Point{Column: 1, Row: 0}},
+					{Color: models.Black, Point: models.Point{Column: 0, Row: 0}},
+				},
+			},
+		},
+		{
+			args: args{
+				root:             newRoot(),
+				pass:             5,
+				maximalMoveCount: 1,
+			},
+			want: Snapshot{
+				Pass:      5,
+				GameCount: 6,
+				Moves: []MoveStatistics{
+					{
+						Move: models.Move{
+							Color: models.White,
+							Point: models.Point{Column: 1, Row: 0},
+						},
+						State: tree.NodeState{GameCount: 3, WinCount: 2},
+					},
+				},
+				PrincipalVariation: []models.Move{
+					{Color: models.White, Point: models.Point{Column: 1, Row: 0}},
+					{Color: models.Black, Point: models.Point{Column: 0, Row: 0}},
+				},
+			},
+		},
+	} {
+		got := NewSnapshot(
+			data.args.root,
+			data.args.pass,
+			data.args.maximalMoveCount,
+		)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package analysis
+
+import (
+	"sort"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/intervals"
+	"github.com/thewizardplusplus/go-atari-montecarlo/selectors"
+	"github.com/thewizardplusplus/go-atari-montecarlo/selectors/scorers"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// DefaultConfidenceScore ...
+//
+// It's the standard score of the 95% confidence level.
+//
+const DefaultConfidenceScore = 1.96
+
+// Variation ...
+//
+// It describes a candidate move of a root: its state is from the point of view
+// of the player that makes the move; the bounds are bounds of the confidence
+// interval of its win rate; the principal variation starts with the move.
+//
+type Variation struct {
+	Move               models.Move
+	State              tree.NodeState
+	LowerBound         float64
+	UpperBound         float64
+	PrincipalVariation []models.Move
+}
+
+// NewVariations ...
+//
+// It returns variations of the best children of the root ranked by game
+// counts. If the count is zero, all children are included.
+//
+// Confidence intervals are Wilson score intervals with the passed standard
+// score (see DefaultConfidenceScore).
+//
+func NewVariations(
+	root *tree.Node,
+	count int,
+	confidenceScore float64,
+) []Variation {
+	children := append(tree.NodeGroup(nil), root.Children...)
+	sort.SliceStable(children, func(i int, j int) bool {
+		return children[i].State.GameCount > children[j].State.GameCount
+	})
+	if count != 0 && len(children) > count {
+		children = children[:count]
+	}
+
+	selector := selectors.MaximalNodeSelector{
+		NodeScorer: scorers.GameCountScorer{},
+	}
+	variations := make([]Variation, 0, len(children))
+	for _, child := range children {
+		principalVariation := []models.Move{child.Move}
+		for _, node := range child.PrincipalVariation(selector) {
+			principalVariation = append(principalVariation, node.Move)
+		}
+
+		lowerBound, upperBound := WilsonInterval(child.State, confidenceScore)
+		variations = append(variations, Variation{
+			Move:               child.Move,
+			State:              child.State,
+			LowerBound:         lowerBound,
+			UpperBound:         upperBound,
+			PrincipalVariation: principalVariation,
+		})
+	}
+
+	return variations
+}
+
+// WilsonInterval ...
+//
+// It returns bounds of the Wilson score interval of the win rate
+// with the passed standard score (see intervals.Wilson()).
+// For a state without games, it returns the whole range [0, 1].
+//
+func WilsonInterval(
+	state tree.NodeState,
+	confidenceScore float64,
+) (lowerBound float64, upperBound float64) {
+	return intervals.Wilson(state.WinCount, state.GameCount, confidenceScore)
+}
@@ -0,0 +1,137 @@
+package analysis
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestNewVariations(test *testing.T) {
+	root := &tree.Node{
+		State: tree.NodeState{GameCount: 6, WinCount: 2},
+		Children: tree.NodeGroup{
+			&tree.Node{
+				Move: models.Move{
+					Color: models.White,
+					Point: models.Point{Column: 0, Row: 0},
+				},
+				State: tree.NodeState{GameCount: 0, WinCount: 0},
+			},
+			&tree.Node{
+				Move: models.Move{
+					Color: models.White,
+					Point: models.Point{Column: 1, Row: 0},
+				},
+				State: tree.NodeState{GameCount: 4, WinCount: 4},
+				Children: tree.NodeGroup{
+					&tree.Node{
+						Move: models.Move{
+							Color: models.Black,
+							Point: models.Point{Column: 0, Row: 0},
+						},
+						State: tree.NodeState{GameCount: 3, WinCount: 0},
+					},
+				},
+			},
+			&tree.Node{
+				Move: models.Move{
+					Color: models.White,
+					Point: models.Point{Column: 2, Row: 0},
+				},
+				State: tree.NodeState{GameCount: 2, WinCount: 0},
+			},
+		},
+	}
+	got := NewVariations(root, 2, 0)
+
+	want := []Variation{
+		{
+			Move: models.Move{
+				Color: models.White,
+				Point: models.Point{Column: 1, Row: 0},
+			},
+			State:      tree.NodeState{GameCount: 4, WinCount: 4},
+			LowerBound: 1,
+			UpperBound: 1,
+			PrincipalVariation: []models.Move{
+				{Color: models.White, Point: models.Point{Column: 1, Row: 0}},
+				{Color: models.Black, Point: models.Point{Column: 0, Row: 0}},
+			},
+		},
+		{
+			Move: models.Move{
+				Color: models.White,
+				Point: models.Point{Column: 2, Row: 0},
+			},
+			State:      tree.NodeState{GameCount: 2, WinCount: 0},
+			LowerBound: 0,
+			UpperBound: 0,
+			PrincipalVariation: []models.Move{
+				{Color: models.White, Point: models.Point{Column: 2, Row: 0}},
+			},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		test.Fail()
+	}
+}
+
+func TestWilsonInterval(test *testing.T) {
+	type args struct {
+		state           tree.NodeState
+		confidenceScore float64
+	}
+	type data struct {
+		args           args
+		wantLowerBound float64
+		wantUpperBound float64
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				state:           tree.NodeState{GameCount: 0, WinCount: 0},
+				confidenceScore: DefaultConfidenceScore,
+			},
+			wantLowerBound: 0,
+			wantUpperBound: 1,
+		},
+		{
+			args: args{
+				state:           tree.NodeState{GameCount: 10, WinCount: 5},
+				confidenceScore: 0,
+			},
+			wantLowerBound: 0.5,
+			wantUpperBound: 0.5,
+		},
+		{
+			args: args{
+				state:           tree.NodeState{GameCount: 10, WinCount: 5},
+				confidenceScore: DefaultConfidenceScore,
+			},
+			wantLowerBound: 0.2366,
+			wantUpperBound: 0.7634,
+		},
+		{
+			args: args{
+				state:           tree.NodeState{GameCount: 10, WinCount: 10},
+				confidenceScore: DefaultConfidenceScore,
+			},
+			wantLowerBound: 0.7225,
+			wantUpperBound: 1,
+		},
+	} {
+		gotLowerBound, gotUpperBound :=
+			WilsonInterval(data.args.state, data.args.confidenceScore)
+
+		if math.Abs(gotLowerBound-data.wantLowerBound) > 1e-4 {
+			test.Fail()
+		}
+		if math.Abs(gotUpperBound-data.wantUpperBound) > 1e-4 {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,40 @@
+package boards
+
+import (
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+// MaximalSide ...
+//
+// It's the maximal width and height of boards accepted from untrusted data,
+// e.g. by decoders and by the server. It equals the count of letters
+// of board coordinates in the Go notation, which skips "I".
+//
+const MaximalSide = 25
+
+// Stones ...
+//
+// It returns stones of the storage in the order of points of its size.
+//
+func Stones(storage models.StoneStorage) []models.Move {
+	var stones []models.Move
+	for _, point := range storage.Size().Points() {
+		if color, ok := storage.Stone(point); ok {
+			stones = append(stones, models.Move{Color: color, Point: point})
+		}
+	}
+
+	return stones
+}
+
+// StoneCount ...
+func StoneCount(storage models.StoneStorage) int {
+	var count int
+	for _, point := range storage.Size().Points() {
+		if _, ok := storage.Stone(point); ok {
+			count++
+		}
+	}
+
+	return count
+}
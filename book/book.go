@@ -0,0 +1,148 @@
+package book
+
+import (
+	"sort"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// MoveStatistics ...
+//
+// Its state is from the point of view of the player that makes the move.
+//
+type MoveStatistics struct {
+	Point models.Point
+	State tree.NodeState
+}
+
+// Book ...
+//
+// It stores statistics of moves by hashes of positions.
+//
+// It isn't safe for concurrent use, if it's changed.
+//
+type Book struct {
+	positions map[uint64][]MoveStatistics
+}
+
+// NewBook ...
+func NewBook() *Book {
+	return &Book{positions: make(map[uint64][]MoveStatistics)}
+}
+
+// PositionCount ...
+func (book *Book) PositionCount() int {
+	return len(book.positions)
+}
+
+// Moves ...
+//
+// It returns statistics of moves of the position sorted by descending game
+// counts. The result shouldn't be changed.
+//
+func (book *Book) Moves(
+	storage models.StoneStorage,
+	nextColor models.Color,
+) []MoveStatistics {
+	return book.positions[Hash(storage, nextColor)]
+}
+
+// AddMove ...
+//
+// It adds the state to statistics of the move in the position before it.
+//
+func (book *Book) AddMove(
+	storage models.StoneStorage,
+	move models.Move,
+	state tree.NodeState,
+) {
+	hash := Hash(storage, move.Color)
+	book.addStatistics(hash, MoveStatistics{Point: move.Point, State: state})
+}
+
+// AddGame ...
+//
+// It adds first moves of the game up to the maximal depth; a move is counted
+// as won if its player is the winner. If the maximal depth is zero, all moves
+// are added.
+//
+func (book *Book) AddGame(
+	size models.Size,
+	moves []models.Move,
+	winner models.Color,
+	maximalDepth int,
+) {
+	storage := models.NewBoard(size)
+	for depth, move := range moves {
+		if maximalDepth != 0 && depth >= maximalDepth {
+			break
+		}
+
+		state := tree.NodeState{GameCount: 1}
+		if move.Color == winner {
+			state.WinCount = 1
+		}
+
+		book.AddMove(storage, move, state)
+		storage = storage.ApplyMove(move)
+	}
+}
+
+// AddTree ...
+//
+// It adds states of nodes of the tree up to the maximal depth relative
+// to the root; nodes with a game count less than the minimal one are skipped
+// together with their subtrees. If the maximal depth is zero, all nodes
+// are added.
+//
+func (book *Book) AddTree(
+	root *tree.Node,
+	maximalDepth int,
+	minimalGameCount int,
+) {
+	book.addTree(root, 1, maximalDepth, minimalGameCount)
+}
+
+func (book *Book) addTree(
+	node *tree.Node,
+	depth int,
+	maximalDepth int,
+	minimalGameCount int,
+) {
+	if maximalDepth != 0 && depth > maximalDepth {
+		return
+	}
+
+	for _, child := range node.Children {
+		if child.State.GameCount == 0 ||
+			child.State.GameCount < minimalGameCount {
+			continue
+		}
+
+		book.AddMove(node.Storage, child.Move, child.State)
+		book.addTree(child, depth+1, maximalDepth, minimalGameCount)
+	}
+}
+
+func (book *Book) addStatistics(hash uint64, statistics MoveStatistics) {
+	moves := book.positions[hash]
+	index := -1
+	for movesIndex, move := range moves {
+		if move.Point == statistics.Point {
+			index = movesIndex
+			break
+		}
+	}
+	if index == -1 {
+		index = len(moves)
+		moves = append(moves, MoveStatistics{Point: statistics.Point})
+	}
+
+	moves[index].State.Update(statistics.State)
+	sort.SliceStable(moves, func(i int, j int) bool {
+		return moves[i].State.GameCount > moves[j].State.GameCount
+	})
+
+	book.positions[hash] = moves
+}
@@ -0,0 +1,143 @@
+package book
+
+import (
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestBookAddMove(test *testing.T) {
+	board := models.NewBoard(models.Size{Width: 3, Height: 3})
+	firstMove := models.Move{Color: models.Black, Point: models.Point{}}
+	secondMove :=
+		models.Move{Color: models.Black, Point: models.Point{Column: 1}}
+
+	book := NewBook()
+	book.AddMove(board, firstMove, tree.NodeState{GameCount: 2, WinCount: 1})
+	book.AddMove(board, secondMove, tree.NodeState{GameCount: 2, WinCount: 2})
+	book.AddMove(board, secondMove, tree.NodeState{GameCount: 1, WinCount: 0})
+
+	wantMoves := []MoveStatistics{
+		{
+			Point: models.Point{Column: 1},
+			State: tree.NodeState{GameCount: 3, WinCount: 2},
+		},
+		{
+			Point: models.Point{},
+			State: tree.NodeState{GameCount: 2, WinCount: 1},
+		},
+	}
+	if book.PositionCount() != 1 {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(book.Moves(board, models.Black), wantMoves) {
+		test.Fail()
+	}
+	if book.Moves(board, models.White) != nil {
+		test.Fail()
+	}
+}
+
+func TestBookAddGame(test *testing.T) {
+	size := models.Size{Width: 3, Height: 3}
+	moves := []models.Move{
+		{Color: models.Black, Point: models.Point{Column: 0, Row: 0}},
+		{Color: models.White, Point: models.Point{Column: 1, Row: 0}},
+		{Color: models.Black, Point: models.Point{Column: 2, Row: 0}},
+	}
+
+	book := NewBook()
+	book.AddGame(size, moves, models.White, 2)
+
+	board := models.NewBoard(size)
+	if book.PositionCount() != 2 {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(book.Moves(board, models.Black), []MoveStatistics{
+		{
+			Point: models.Point{Column: 0, Row: 0},
+			State: tree.NodeState{GameCount: 1, WinCount: 0},
+		},
+	}) {
+		test.Fail()
+	}
+
+	board = board.ApplyMove(moves[0])
+	if !reflect.DeepEqual(book.Moves(board, models.White), []MoveStatistics{
+		{
+			Point: models.Point{Column: 1, Row: 0},
+			State: tree.NodeState{GameCount: 1, WinCount: 1},
+		},
+	}) {
+		test.Fail()
+	}
+}
+
+func TestBookAddTree(test *testing.T) {
+	root := newTestTree()
+
+	book := NewBook()
+	book.AddTree(root, 1, 2)
+
+	if book.PositionCount() != 1 {
+		test.Fail()
+	}
+	wantMoves := []MoveStatistics{
+		{
+			Point: models.Point{Column: 0, Row: 0},
+			State: tree.NodeState{GameCount: 3, WinCount: 2},
+		},
+	}
+	if !reflect.DeepEqual(book.Moves(root.Storage, models.Black), wantMoves) {
+		test.Fail()
+	}
+
+	book = NewBook()
+	book.AddTree(root, 0, 0)
+
+	if book.PositionCount() != 2 {
+		test.Fail()
+	}
+	if len(book.Moves(root.Storage, models.Black)) != 2 {
+		test.Fail()
+	}
+}
+
+func newTestTree() *tree.Node {
+	root := &tree.Node{
+		Move:    models.NewPreliminaryMove(models.Black),
+		Storage: models.NewBoard(models.Size{Width: 3, Height: 3}),
+		State:   tree.NodeState{GameCount: 4, WinCount: 2},
+	}
+	for index, state := range []tree.NodeState{
+		{GameCount: 3, WinCount: 2},
+		{GameCount: 1, WinCount: 0},
+	} {
+		move := models.Move{
+			Color: models.Black,
+			Point: models.Point{Column: index, Row: 0},
+		}
+		child := &tree.Node{
+			Parent:  root,
+			Move:    move,
+			Storage: root.Storage.ApplyMove(move),
+			State:   state,
+		}
+		root.Children = append(root.Children, child)
+	}
+
+	firstChild := root.Children[0]
+	move := models.Move{Color: models.White, Point: models.Point{Row: 1}}
+	firstChild.Children = tree.NodeGroup{
+		{
+			Parent:  firstChild,
+			Move:    move,
+			Storage: firstChild.Storage.ApplyMove(move),
+			State:   tree.NodeState{GameCount: 2, WinCount: 1},
+		},
+	}
+
+	return root
+}
@@ -0,0 +1,151 @@
+package book
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+	"sort"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/boards"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+	"github.com/thewizardplusplus/go-atari-montecarlo/varints"
+)
+
+const (
+	signature        = "AMOB"
+	version          = 1
+	maximalMoveCount = boards.MaximalSide * boards.MaximalSide
+)
+
+// ...
+var (
+	ErrInvalidData = varints.ErrInvalidData
+)
+
+// Encode ...
+//
+// It uses the following format (all numbers are varints, except hashes):
+//
+//   - the signature and the format version;
+//   - the position count;
+//   - positions sorted by hashes: the hash (8 bytes, little endian),
+//     the move count and moves: the column, the row and the move state.
+//
+func (book *Book) Encode(writer io.Writer) error {
+	hashes := make([]uint64, 0, len(book.positions))
+	for hash := range book.positions {
+		hashes = append(hashes, hash)
+	}
+	sort.Slice(hashes, func(i int, j int) bool { return hashes[i] < hashes[j] })
+
+	binaryWriter := varints.NewWriter(writer)
+	binaryWriter.Write([]byte(signature))
+	binaryWriter.WriteUvarint(version)
+	binaryWriter.WriteUvarint(uint64(len(hashes)))
+	for _, hash := range hashes {
+		var hashBytes [8]byte
+		binary.LittleEndian.PutUint64(hashBytes[:], hash)
+		binaryWriter.Write(hashBytes[:])
+
+		moves := book.positions[hash]
+		binaryWriter.WriteUvarint(uint64(len(moves)))
+		for _, move := range moves {
+			binaryWriter.WriteUvarint(uint64(move.Point.Column))
+			binaryWriter.WriteUvarint(uint64(move.Point.Row))
+			binaryWriter.WriteUvarint(uint64(move.State.GameCount))
+			binaryWriter.WriteUvarint(uint64(move.State.WinCount))
+		}
+	}
+
+	return binaryWriter.Flush()
+}
+
+// Decode ...
+//
+// Returned error can be ErrInvalidData or an error of the reader.
+//
+func Decode(reader io.Reader) (*Book, error) {
+	bufferedReader := bufio.NewReader(reader)
+
+	signatureBytes := make([]byte, len(signature))
+	if _, err := io.ReadFull(bufferedReader, signatureBytes); err != nil {
+		return nil, err
+	}
+	if string(signatureBytes) != signature {
+		return nil, ErrInvalidData
+	}
+
+	formatVersion, err := binary.ReadUvarint(bufferedReader)
+	if err != nil {
+		return nil, err
+	}
+	if formatVersion != version {
+		return nil, ErrInvalidData
+	}
+
+	positionCount, err := varints.ReadInt(bufferedReader, varints.MaximalInt)
+	if err != nil {
+		return nil, err
+	}
+
+	book := NewBook()
+	for i := 0; i < positionCount; i++ {
+		var hashBytes [8]byte
+		if _, err := io.ReadFull(bufferedReader, hashBytes[:]); err != nil {
+			return nil, err
+		}
+
+		hash := binary.LittleEndian.Uint64(hashBytes[:])
+		if _, ok := book.positions[hash]; ok {
+			return nil, ErrInvalidData
+		}
+
+		moveCount, err := varints.ReadInt(bufferedReader, maximalMoveCount)
+		if err != nil {
+			return nil, err
+		}
+
+		// moves aren't preallocated by the count from the untrusted data,
+		// so the allocated memory is bounded by the data length
+		var moves []MoveStatistics
+		for j := 0; j < moveCount; j++ {
+			move, err := readMove(bufferedReader)
+			if err != nil {
+				return nil, err
+			}
+
+			moves = append(moves, move)
+		}
+
+		book.positions[hash] = moves
+	}
+
+	return book, nil
+}
+
+func readMove(reader io.ByteReader) (MoveStatistics, error) {
+	var values [4]int
+	for index := range values {
+		maximum := varints.MaximalInt
+		if index < 2 {
+			maximum = boards.MaximalSide - 1
+		}
+
+		value, err := varints.ReadInt(reader, maximum)
+		if err != nil {
+			return MoveStatistics{}, err
+		}
+
+		values[index] = value
+	}
+	if values[3] > values[2] {
+		return MoveStatistics{}, ErrInvalidData
+	}
+
+	move := MoveStatistics{
+		Point: models.Point{Column: values[0], Row: values[1]},
+		State: tree.NodeState{GameCount: values[2], WinCount: values[3]},
+	}
+	return move, nil
+}
@@ -0,0 +1,81 @@
+package book
+
+import (
+	"bytes"
+	"io"
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestBookEncode(test *testing.T) {
+	book := NewBook()
+	book.AddTree(newTestTree(), 0, 0)
+
+	var buffer bytes.Buffer
+	err := book.Encode(&buffer)
+	if err != nil {
+		test.Fail()
+	}
+
+	decodedBook, err := Decode(&buffer)
+	if !reflect.DeepEqual(decodedBook, book) {
+		test.Fail()
+	}
+	if err != nil {
+		test.Fail()
+	}
+}
+
+func TestDecode_withError(test *testing.T) {
+	book := NewBook()
+	book.AddMove(
+		models.NewBoard(models.Size{Width: 3, Height: 3}),
+		models.Move{Color: models.Black, Point: models.Point{Column: 1}},
+		tree.NodeState{GameCount: 2, WinCount: 1},
+	)
+
+	var buffer bytes.Buffer
+	book.Encode(&buffer) // nolint: errcheck
+	validData := buffer.Bytes()
+
+	type data struct {
+		data    []byte
+		wantErr error
+	}
+
+	for _, data := range []data{
+		{
+			data:    []byte("AMCT"),
+			wantErr: ErrInvalidData,
+		},
+		{
+			data:    append([]byte("AMOB"), 2),
+			wantErr: ErrInvalidData,
+		},
+		{
+			data:    validData[:len(validData)-1],
+			wantErr: io.EOF,
+		},
+		{
+			// the win count is greater than the game count
+			data: func() []byte {
+				invalidData := append([]byte(nil), validData...)
+				invalidData[len(invalidData)-1] = 3
+				return invalidData
+			}(),
+			wantErr: ErrInvalidData,
+		},
+	} {
+		book, err := Decode(bytes.NewReader(data.data))
+
+		if book != nil {
+			test.Fail()
+		}
+		if err != data.wantErr {
+			test.Fail()
+		}
+	}
+}
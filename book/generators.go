@@ -0,0 +1,123 @@
+package book
+
+import (
+	"math/rand"
+	"sort"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/selfplay"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// SearchGenerator ...
+//
+// It fills a book by deep searches: it searches positions up to the maximal
+// depth and adds statistics of root children with a game count not less than
+// the minimal one. Only the most played children (up to the branching factor)
+// are searched further.
+//
+type SearchGenerator struct {
+	Searcher         selfplay.Searcher
+	MaximalDepth     int
+	BranchingFactor  int
+	MinimalGameCount int
+}
+
+// Generate ...
+//
+// Returned error can be an error of the searcher, except models.ErrAlreadyLoss
+// and models.ErrAlreadyWin.
+//
+func (generator SearchGenerator) Generate(
+	book *Book,
+	storage models.StoneStorage,
+	previousMove models.Move,
+) error {
+	return generator.generate(book, storage, previousMove, 0)
+}
+
+func (generator SearchGenerator) generate(
+	book *Book,
+	storage models.StoneStorage,
+	previousMove models.Move,
+	depth int,
+) error {
+	if depth >= generator.MaximalDepth {
+		return nil
+	}
+
+	root := &tree.Node{Move: previousMove, Storage: storage}
+	switch _, err := generator.Searcher.SearchMove(root); err {
+	case nil:
+	case models.ErrAlreadyLoss, models.ErrAlreadyWin:
+		return nil
+	default:
+		return err
+	}
+
+	book.AddTree(root, 1, generator.MinimalGameCount)
+
+	children := append(tree.NodeGroup(nil), root.Children...)
+	sort.SliceStable(children, func(i int, j int) bool {
+		return children[i].State.GameCount > children[j].State.GameCount
+	})
+	for index, child := range children {
+		if index >= generator.BranchingFactor ||
+			child.State.GameCount == 0 ||
+			child.State.GameCount < generator.MinimalGameCount {
+			break
+		}
+
+		err := generator.generate(book, child.Storage, child.Move, depth+1)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SelfPlayGenerator ...
+//
+// It fills a book by self-play games; see the method Book.AddGame().
+//
+type SelfPlayGenerator struct {
+	Game         selfplay.Game
+	GameCount    int
+	MaximalDepth int
+	// Opening moves of the game with an index are generated by a randomizer
+	// with the seed plus the index.
+	Seed int64
+}
+
+// Generate ...
+//
+// Players alternate colors, the first player is black in even games.
+//
+// Returned error can be an error of any player.
+//
+func (generator SelfPlayGenerator) Generate(
+	book *Book,
+	first selfplay.Searcher,
+	second selfplay.Searcher,
+) error {
+	for gameIndex := 0; gameIndex < generator.GameCount; gameIndex++ {
+		black, white := first, second
+		if gameIndex%2 != 0 {
+			black, white = second, first
+		}
+
+		seed := generator.Seed + int64(gameIndex)
+		randomizer := rand.New(rand.NewSource(seed))
+		winner, moves, err :=
+			generator.Game.PlayRecorded(black, white, randomizer)
+		if err != nil {
+			return err
+		}
+
+		size := generator.Game.Size
+		book.AddGame(size, moves, winner, generator.MaximalDepth)
+	}
+
+	return nil
+}
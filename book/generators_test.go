@@ -0,0 +1,135 @@
+package book
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/selfplay"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+type MockSearcher struct {
+	searchMove func(root *tree.Node) (*tree.Node, error)
+}
+
+func (searcher MockSearcher) SearchMove(root *tree.Node) (*tree.Node, error) {
+	if searcher.searchMove == nil {
+		panic("not implemented")
+	}
+
+	return searcher.searchMove(root)
+}
+
+// it expands the root by legal moves; the game count of a child is equal
+// to the count of legal moves minus the child index
+func newExpandingSearcher() MockSearcher {
+	return MockSearcher{
+		searchMove: func(root *tree.Node) (*tree.Node, error) {
+			generator := models.MoveGenerator{}
+			moves, err := generator.LegalMoves(root.Storage, root.Move)
+			if err != nil {
+				return nil, err
+			}
+
+			for index, move := range moves {
+				root.Children = append(root.Children, &tree.Node{
+					Parent:  root,
+					Move:    move,
+					Storage: root.Storage.ApplyMove(move),
+					State:   tree.NodeState{GameCount: len(moves) - index},
+				})
+			}
+
+			return root.Children[0], nil
+		},
+	}
+}
+
+func TestSearchGeneratorGenerate(test *testing.T) {
+	generator := SearchGenerator{
+		Searcher:         newExpandingSearcher(),
+		MaximalDepth:     2,
+		BranchingFactor:  2,
+		MinimalGameCount: 8,
+	}
+	board := models.NewBoard(models.Size{Width: 3, Height: 3})
+	previousMove := models.NewPreliminaryMove(models.Black)
+
+	book := NewBook()
+	err := generator.Generate(book, board, previousMove)
+
+	// the root has 9 children, 2 of them have enough games;
+	// each of the latter has 8 children, 1 of them has enough games
+	if book.PositionCount() != 3 {
+		test.Fail()
+	}
+	if len(book.Moves(board, models.Black)) != 2 {
+		test.Fail()
+	}
+	if err != nil {
+		test.Fail()
+	}
+}
+
+func TestSearchGeneratorGenerate_withError(test *testing.T) {
+	searcherErr := errors.New("searcher error")
+	generator := SearchGenerator{
+		Searcher: MockSearcher{
+			searchMove: func(root *tree.Node) (*tree.Node, error) {
+				return nil, searcherErr
+			},
+		},
+		MaximalDepth: 2,
+	}
+	board := models.NewBoard(models.Size{Width: 3, Height: 3})
+	previousMove := models.NewPreliminaryMove(models.Black)
+
+	book := NewBook()
+	err := generator.Generate(book, board, previousMove)
+
+	if book.PositionCount() != 0 {
+		test.Fail()
+	}
+	if err != searcherErr {
+		test.Fail()
+	}
+}
+
+func TestSelfPlayGeneratorGenerate(test *testing.T) {
+	size := models.Size{Width: 3, Height: 3}
+	generator := SelfPlayGenerator{
+		Game: selfplay.Game{
+			MoveGenerator: models.MoveGenerator{},
+			Size:          size,
+		},
+		GameCount:    2,
+		MaximalDepth: 1,
+	}
+
+	book := NewBook()
+	err := generator.Generate(
+		book,
+		newExpandingSearcher(),
+		newExpandingSearcher(),
+	)
+
+	// white always wins
+	wantMoves := []MoveStatistics{
+		{
+			Point: models.Point{Column: 0, Row: 0},
+			State: tree.NodeState{GameCount: 2, WinCount: 0},
+		},
+	}
+	if book.PositionCount() != 1 {
+		test.Fail()
+	}
+	board := models.NewBoard(size)
+	if !reflect.DeepEqual(book.Moves(board, models.Black), wantMoves) {
+		test.Fail()
+	}
+	if err != nil {
+		test.Fail()
+	}
+}
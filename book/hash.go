@@ -0,0 +1,35 @@
+package book
+
+import (
+	"hash/fnv"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+// Hash ...
+//
+// It returns the 64-bit FNV-1a hash of the position: the board size,
+// the color of the next move and the stones.
+//
+func Hash(storage models.StoneStorage, nextColor models.Color) uint64 {
+	size := storage.Size()
+	data := []byte{
+		byte(size.Width),
+		byte(size.Width >> 8),
+		byte(size.Height),
+		byte(size.Height >> 8),
+		byte(nextColor),
+	}
+	for _, point := range size.Points() {
+		cell := byte(0)
+		if color, ok := storage.Stone(point); ok {
+			cell = byte(color) + 1
+		}
+
+		data = append(data, cell)
+	}
+
+	hash := fnv.New64a()
+	hash.Write(data) // nolint: errcheck
+	return hash.Sum64()
+}
@@ -0,0 +1,35 @@
+package book
+
+import (
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+func TestHash(test *testing.T) {
+	size := models.Size{Width: 3, Height: 3}
+	board := models.NewBoard(size)
+	move := models.Move{Color: models.Black, Point: models.Point{Column: 1}}
+	anotherMove :=
+		models.Move{Color: models.White, Point: models.Point{Column: 1}}
+
+	hashes := []uint64{
+		Hash(board, models.Black),
+		Hash(board, models.White),
+		Hash(models.NewBoard(models.Size{Width: 3, Height: 4}), models.Black),
+		Hash(board.ApplyMove(move), models.White),
+		Hash(board.ApplyMove(anotherMove), models.White),
+	}
+	for i := range hashes {
+		for j := i + 1; j < len(hashes); j++ {
+			if hashes[i] == hashes[j] {
+				test.Fail()
+			}
+		}
+	}
+
+	if Hash(board.ApplyMove(move), models.White) !=
+		Hash(models.NewBoard(size).ApplyMove(move), models.White) {
+		test.Fail()
+	}
+}
@@ -0,0 +1,18 @@
+package builders
+
+// BuilderFactory ...
+type BuilderFactory interface {
+	NewBuilder() Builder
+}
+
+// BuilderFactoryFunc ...
+//
+// It allows to use an ordinary function as a builder factory, e.g.
+// for creating of builders with a state, like PruningBuilder.
+//
+type BuilderFactoryFunc func() Builder
+
+// NewBuilder ...
+func (factory BuilderFactoryFunc) NewBuilder() Builder {
+	return factory()
+}
@@ -0,0 +1,40 @@
+package builders
+
+import (
+	"github.com/thewizardplusplus/go-atari-montecarlo/builders/terminators"
+	"github.com/thewizardplusplus/go-atari-montecarlo/observers"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// FactoryBuilder ...
+//
+// It's an equivalent of IterativeBuilder, but it creates a new terminator
+// for each call of the method Pass(), e.g. for each search of a move.
+//
+// If a builder factory is set, it also creates a new inner builder for each
+// call of the method Pass(), so builders with a state aren't shared between
+// searches and parallel builders.
+//
+type FactoryBuilder struct {
+	Builder Builder
+	// If it's set, the builder is ignored.
+	BuilderFactory    BuilderFactory
+	TerminatorFactory terminators.TerminatorFactory
+	// If it's nil, observers.NopObserver is used.
+	Observer observers.Observer
+}
+
+// Pass ...
+func (builder FactoryBuilder) Pass(root *tree.Node) {
+	innerBuilder := builder.Builder
+	if builder.BuilderFactory != nil {
+		innerBuilder = builder.BuilderFactory.NewBuilder()
+	}
+
+	iterativeBuilder := IterativeBuilder{
+		Builder:    innerBuilder,
+		Terminator: builder.TerminatorFactory.NewTerminator(root),
+		Observer:   builder.Observer,
+	}
+	iterativeBuilder.Pass(root)
+}
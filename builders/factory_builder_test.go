@@ -0,0 +1,78 @@
+package builders
+
+import (
+	"testing"
+
+	"github.com/thewizardplusplus/go-atari-montecarlo/builders/terminators"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+type MockTerminatorFactory struct {
+	newTerminator func(root *tree.Node) terminators.BuildingTerminator
+}
+
+func (factory MockTerminatorFactory) NewTerminator(
+	root *tree.Node,
+) terminators.BuildingTerminator {
+	if factory.newTerminator == nil {
+		panic("not implemented")
+	}
+
+	return factory.newTerminator(root)
+}
+
+func TestFactoryBuilderPass(test *testing.T) {
+	var passCount, terminatorCount int
+	builder := FactoryBuilder{
+		Builder: MockBuilder{
+			pass: func(root *tree.Node) { passCount++ },
+		},
+		TerminatorFactory: MockTerminatorFactory{
+			newTerminator: func(
+				root *tree.Node,
+			) terminators.BuildingTerminator {
+				terminatorCount++
+				return terminators.NewPassTerminator(3)
+			},
+		},
+	}
+	root := &tree.Node{}
+	builder.Pass(root)
+	builder.Pass(root)
+
+	if passCount != 6 {
+		test.Fail()
+	}
+	if terminatorCount != 2 {
+		test.Fail()
+	}
+}
+
+func TestFactoryBuilderPass_withBuilderFactory(test *testing.T) {
+	var passCount, builderCount int
+	builder := FactoryBuilder{
+		BuilderFactory: BuilderFactoryFunc(func() Builder {
+			builderCount++
+			return MockBuilder{
+				pass: func(root *tree.Node) { passCount++ },
+			}
+		}),
+		TerminatorFactory: MockTerminatorFactory{
+			newTerminator: func(
+				root *tree.Node,
+			) terminators.BuildingTerminator {
+				return terminators.NewPassTerminator(3)
+			},
+		},
+	}
+	root := &tree.Node{}
+	builder.Pass(root)
+	builder.Pass(root)
+
+	if passCount != 6 {
+		test.Fail()
+	}
+	if builderCount != 2 {
+		test.Fail()
+	}
+}
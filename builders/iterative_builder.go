@@ -2,6 +2,7 @@ package builders
 
 import (
 	"github.com/thewizardplusplus/go-atari-montecarlo/builders/terminators"
+	"github.com/thewizardplusplus/go-atari-montecarlo/observers"
 	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
 )
 
@@ -14,12 +15,17 @@ type Builder interface {
 type IterativeBuilder struct {
 	Builder    Builder
 	Terminator terminators.BuildingTerminator
+	// If it's nil, observers.NopObserver is used.
+	Observer observers.Observer
 }
 
 // Pass ...
 func (builder IterativeBuilder) Pass(root *tree.Node) {
+	observer := observers.OrNop(builder.Observer)
 	isBuildingTerminated := builder.Terminator.IsBuildingTerminated
 	for pass := 0; !isBuildingTerminated(pass); pass++ {
+		observer.OnPassStart(root, pass)
 		builder.Builder.Pass(root)
+		observer.OnPassEnd(root, pass)
 	}
 }
@@ -136,3 +136,29 @@ func TestIterativeBuilderPass(test *testing.T) {
 		}
 	}
 }
+
+func TestIterativeBuilderPass_withObserver(test *testing.T) {
+	var events []string
+	builder := IterativeBuilder{
+		Builder: MockBuilder{
+			pass: func(root *tree.Node) { events = append(events, "pass") },
+		},
+		Terminator: MockBuildingTerminator{
+			isBuildingTerminated: func(pass int) bool { return pass >= 2 },
+		},
+		Observer: RecordingObserver{events: &events},
+	}
+	builder.Pass(&tree.Node{})
+
+	wantEvents := []string{
+		"pass start 0",
+		"pass",
+		"pass end 0",
+		"pass start 1",
+		"pass",
+		"pass end 1",
+	}
+	if !reflect.DeepEqual(events, wantEvents) {
+		test.Fail()
+	}
+}
@@ -0,0 +1,51 @@
+package builders
+
+import (
+	"fmt"
+
+	"github.com/thewizardplusplus/go-atari-montecarlo/observers"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+type RecordingObserver struct {
+	observers.NopObserver
+
+	events *[]string
+}
+
+func (observer RecordingObserver) OnPassStart(root *tree.Node, pass int) {
+	observer.record(fmt.Sprintf("pass start %d", pass))
+}
+
+func (observer RecordingObserver) OnPassEnd(root *tree.Node, pass int) {
+	observer.record(fmt.Sprintf("pass end %d", pass))
+}
+
+func (observer RecordingObserver) OnLeafSelection(leaf *tree.Node) {
+	observer.record(fmt.Sprintf("leaf selection %v", leaf.Move.Point))
+}
+
+func (observer RecordingObserver) OnLeafExpansion(
+	leaf *tree.Node,
+	children tree.NodeGroup,
+) {
+	observer.record(fmt.Sprintf("leaf expansion %d", len(children)))
+}
+
+func (observer RecordingObserver) OnSimulation(
+	node *tree.Node,
+	state tree.NodeState,
+) {
+	observer.record(fmt.Sprintf("simulation %v %+v", node.Move.Point, state))
+}
+
+func (observer RecordingObserver) OnMerge(
+	root *tree.Node,
+	rootCopy *tree.Node,
+) {
+	observer.record(fmt.Sprintf("merge %d", len(root.Children)))
+}
+
+func (observer RecordingObserver) record(event string) {
+	*observer.events = append(*observer.events, event)
+}
@@ -1,6 +1,7 @@
 package builders
 
 import (
+	"github.com/thewizardplusplus/go-atari-montecarlo/observers"
 	syncutils "github.com/thewizardplusplus/go-atari-montecarlo/sync-utils"
 	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
 )
@@ -9,6 +10,11 @@ import (
 type ParallelBuilder struct {
 	Builder     Builder
 	Concurrency int
+	// If it's nil, children of merged root copies are left to the garbage
+	// collector.
+	NodePool *tree.NodePool
+	// If it's nil, observers.NopObserver is used.
+	Observer observers.Observer
 }
 
 // Pass ...
@@ -23,7 +29,20 @@ func (builder ParallelBuilder) Pass(root *tree.Node) {
 		},
 	)
 
+	observer := observers.OrNop(builder.Observer)
 	for _, rootCopy := range roots {
-		root.MergeChildren(rootCopy.(*tree.Node))
+		rootCopy := rootCopy.(*tree.Node)
+		if len(root.Children) == 0 {
+			// the root will borrow children of the copy
+			root.MergeChildren(rootCopy)
+			observer.OnMerge(root, rootCopy)
+
+			continue
+		}
+
+		root.MergeChildren(rootCopy)
+		observer.OnMerge(root, rootCopy)
+
+		builder.NodePool.Free(rootCopy.Children...)
 	}
 }
@@ -228,3 +228,24 @@ func TestParallelBuilderPass(test *testing.T) {
 		test.Fail()
 	}
 }
+
+func TestParallelBuilderPass_withObserver(test *testing.T) {
+	var events []string
+	builder := ParallelBuilder{
+		Builder: MockBuilder{
+			pass: func(root *tree.Node) {
+				root.Children = tree.NodeGroup{
+					&tree.Node{State: tree.NodeState{GameCount: 1, WinCount: 1}},
+				}
+			},
+		},
+		Concurrency: 2,
+		Observer:    RecordingObserver{events: &events},
+	}
+	builder.Pass(&tree.Node{})
+
+	wantEvents := []string{"merge 1", "merge 1"}
+	if !reflect.DeepEqual(events, wantEvents) {
+		test.Fail()
+	}
+}
@@ -0,0 +1,25 @@
+package builders
+
+import (
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// BuildPosition ...
+//
+// It creates a root for the position with an explicit side to move,
+// performs a pass of the builder on it and returns the root.
+//
+// Returned error can be tree.ErrInconsistentHistory only.
+//
+func BuildPosition(
+	builder Builder,
+	position tree.Position,
+) (*tree.Node, error) {
+	root, err := position.NewRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	builder.Pass(root)
+	return root, nil
+}
@@ -0,0 +1,69 @@
+package builders
+
+import (
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestBuildPosition(test *testing.T) {
+	lastMove := models.Move{Color: models.Black, Point: models.Point{}}
+	storage := models.NewBoard(models.Size{Width: 3, Height: 3}).
+		ApplyMove(lastMove)
+
+	type data struct {
+		position tree.Position
+		wantMove models.Move
+		wantErr  error
+	}
+
+	for _, data := range []data{
+		{
+			position: tree.Position{
+				Storage:    storage,
+				SideToMove: models.White,
+			},
+			wantMove: models.NewPreliminaryMove(models.White),
+			wantErr:  nil,
+		},
+		{
+			position: tree.Position{
+				Storage:    storage,
+				SideToMove: models.White,
+				History:    []models.Move{lastMove},
+			},
+			wantMove: lastMove,
+			wantErr:  nil,
+		},
+		{
+			position: tree.Position{
+				Storage:    storage,
+				SideToMove: models.Black,
+				History:    []models.Move{lastMove},
+			},
+			wantErr: tree.ErrInconsistentHistory,
+		},
+	} {
+		var passedRoot *tree.Node
+		builder := MockBuilder{
+			pass: func(root *tree.Node) { passedRoot = root },
+		}
+		root, err := BuildPosition(builder, data.position)
+
+		var wantRoot *tree.Node
+		if data.wantErr == nil {
+			wantRoot = &tree.Node{Move: data.wantMove, Storage: storage}
+		}
+		if !reflect.DeepEqual(root, wantRoot) {
+			test.Fail()
+		}
+		if passedRoot != root {
+			test.Fail()
+		}
+		if err != data.wantErr {
+			test.Fail()
+		}
+	}
+}
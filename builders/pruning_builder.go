@@ -0,0 +1,59 @@
+package builders
+
+import (
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// PruningBuilder ...
+//
+// After a pass of the inner builder, if the tree exceeds the maximal node
+// count, it prunes the least visited subtrees of the tree down to the minimal
+// node count. So pruning is performed in batches, not after each pass.
+//
+// The node count is tracked via tree.NodeCounter, so the inner builder
+// should add no more nodes than there are points on the board per pass,
+// like TreeBuilder does.
+//
+// It should be used inside IterativeBuilder, so the tree is bounded
+// between iterations. It has a state for the current root, so it isn't safe
+// for concurrent use; use FactoryBuilder with a builder factory to create
+// a new instance for each search.
+//
+type PruningBuilder struct {
+	Builder          Builder
+	MaximalNodeCount int
+	// If it isn't positive or exceeds the maximal node count, three quarters
+	// of the maximal node count are used.
+	MinimalNodeCount int
+	// If it's nil, pruned nodes are left to the garbage collector.
+	NodePool *tree.NodePool
+
+	root    *tree.Node
+	counter *tree.NodeCounter
+	pass    int
+}
+
+// Pass ...
+func (builder *PruningBuilder) Pass(root *tree.Node) {
+	if root != builder.root {
+		builder.root = root
+		builder.counter = tree.NewNodeCounter(root)
+		builder.pass = 0
+	}
+
+	builder.Builder.Pass(root)
+	builder.pass++
+
+	if !builder.counter.IsReached(builder.pass, builder.MaximalNodeCount+1) {
+		return
+	}
+
+	minimalNodeCount := builder.MinimalNodeCount
+	if minimalNodeCount <= 0 || minimalNodeCount > builder.MaximalNodeCount {
+		minimalNodeCount = builder.MaximalNodeCount * 3 / 4
+	}
+
+	prunedNodes := root.Prune(minimalNodeCount)
+	builder.NodePool.Free(prunedNodes...)
+	builder.counter.Recount(builder.pass)
+}
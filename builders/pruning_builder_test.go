@@ -0,0 +1,126 @@
+package builders
+
+import (
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestPruningBuilderPass(test *testing.T) {
+	type fields struct {
+		maximalNodeCount int
+		minimalNodeCount int
+	}
+	type data struct {
+		fields        fields
+		wantNodeCount int
+	}
+
+	for _, data := range []data{
+		{
+			fields:        fields{5, 0},
+			wantNodeCount: 5,
+		},
+		{
+			fields:        fields{4, 3},
+			wantNodeCount: 3,
+		},
+		{
+			fields:        fields{3, 0},
+			wantNodeCount: 3,
+		},
+		{
+			fields:        fields{4, -1},
+			wantNodeCount: 3,
+		},
+		{
+			fields:        fields{4, 5},
+			wantNodeCount: 3,
+		},
+	} {
+		root := &tree.Node{
+			Storage: models.NewBoard(models.Size{Width: 3, Height: 3}),
+			State:   tree.NodeState{GameCount: 4},
+		}
+		innerBuilder := MockBuilder{
+			pass: func(root *tree.Node) {
+				// root
+				// +-- child: 3 games
+				// |   +-- child: 2 games
+				// |   +-- child: 1 game
+				// +-- child: 1 game
+				childOne := &tree.Node{
+					Parent: root,
+					State:  tree.NodeState{GameCount: 3},
+				}
+				childOne.Children = tree.NodeGroup{
+					&tree.Node{
+						Parent: childOne,
+						State:  tree.NodeState{GameCount: 2},
+					},
+					&tree.Node{
+						Parent: childOne,
+						State:  tree.NodeState{GameCount: 1},
+					},
+				}
+				childTwo := &tree.Node{
+					Parent: root,
+					State:  tree.NodeState{GameCount: 1},
+				}
+				root.Children = tree.NodeGroup{childOne, childTwo}
+			},
+		}
+		builder := &PruningBuilder{
+			Builder:          innerBuilder,
+			MaximalNodeCount: data.fields.maximalNodeCount,
+			MinimalNodeCount: data.fields.minimalNodeCount,
+		}
+		builder.Pass(root)
+
+		if got := root.NodeCount(); got != data.wantNodeCount {
+			test.Fail()
+		}
+		if root.State.GameCount != 4 || root.Children[0].State.GameCount != 3 {
+			test.Fail()
+		}
+	}
+}
+
+func TestPruningBuilderPass_withBatches(test *testing.T) {
+	root := &tree.Node{
+		Storage: models.NewBoard(models.Size{Width: 3, Height: 3}),
+	}
+	innerBuilder := MockBuilder{
+		pass: func(root *tree.Node) {
+			// each pass adds a child with a grandchild, and the newer child
+			// is the more visited one
+			child := &tree.Node{
+				Parent: root,
+				State:  tree.NodeState{GameCount: len(root.Children) + 1},
+			}
+			child.Children = tree.NodeGroup{&tree.Node{Parent: child}}
+			root.Children = append(root.Children, child)
+		},
+	}
+	builder := &PruningBuilder{
+		Builder:          innerBuilder,
+		MaximalNodeCount: 6,
+		MinimalNodeCount: 4,
+	}
+
+	var nodeCounts []int
+	for pass := 0; pass < 4; pass++ {
+		builder.Pass(root)
+		nodeCounts = append(nodeCounts, root.NodeCount())
+	}
+
+	// the tree is pruned down to the minimal node count after the third pass
+	// only, and then it grows again without pruning
+	wantNodeCounts := []int{3, 5, 4, 6}
+	if !reflect.DeepEqual(nodeCounts, wantNodeCounts) {
+		test.Log(nodeCounts)
+		test.Fail()
+	}
+}
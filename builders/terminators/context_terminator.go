@@ -0,0 +1,24 @@
+package terminators
+
+import (
+	"context"
+)
+
+// ContextTerminator ...
+//
+// It terminates building, when the context is done, e.g. on cancellation
+// of a request or on expiration of its deadline. It's safe for concurrent use.
+//
+type ContextTerminator struct {
+	context context.Context
+}
+
+// NewContextTerminator ...
+func NewContextTerminator(ctx context.Context) ContextTerminator {
+	return ContextTerminator{ctx}
+}
+
+// IsBuildingTerminated ...
+func (terminator ContextTerminator) IsBuildingTerminated(pass int) bool {
+	return terminator.context.Err() != nil
+}
@@ -0,0 +1,21 @@
+package terminators
+
+import (
+	"context"
+	"testing"
+)
+
+func TestContextTerminatorIsBuildingTerminated(test *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	terminator := NewContextTerminator(ctx)
+	if terminator.IsBuildingTerminated(5) {
+		test.Fail()
+	}
+
+	cancel()
+	if !terminator.IsBuildingTerminated(5) {
+		test.Fail()
+	}
+}
@@ -0,0 +1,64 @@
+package terminators
+
+import (
+	"math"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// ConvergenceTerminator ...
+//
+// It terminates building when win rates of all root children change less than
+// the tolerance during the specified count of consecutive passes.
+//
+type ConvergenceTerminator struct {
+	root            *tree.Node
+	tolerance       float64
+	stablePassCount int
+
+	winRates        map[models.Move]float64
+	stablePassIndex int
+}
+
+// NewConvergenceTerminator ...
+func NewConvergenceTerminator(
+	root *tree.Node,
+	tolerance float64,
+	stablePassCount int,
+) *ConvergenceTerminator {
+	return &ConvergenceTerminator{
+		root:            root,
+		tolerance:       tolerance,
+		stablePassCount: stablePassCount,
+	}
+}
+
+// IsBuildingTerminated ...
+func (terminator *ConvergenceTerminator) IsBuildingTerminated(pass int) bool {
+	if len(terminator.root.Children) == 0 {
+		return false
+	}
+
+	isStable := terminator.winRates != nil
+	winRates := make(map[models.Move]float64, len(terminator.root.Children))
+	for _, child := range terminator.root.Children {
+		winRate := child.State.WinRate()
+		winRates[child.Move] = winRate
+
+		previousWinRate, ok := terminator.winRates[child.Move]
+		if !ok || math.IsInf(winRate, +1) || math.IsInf(previousWinRate, +1) ||
+			math.Abs(winRate-previousWinRate) >= terminator.tolerance {
+			isStable = false
+		}
+	}
+	terminator.winRates = winRates
+
+	if !isStable {
+		terminator.stablePassIndex = 0
+		return false
+	}
+
+	terminator.stablePassIndex++
+	return terminator.stablePassIndex >= terminator.stablePassCount
+}
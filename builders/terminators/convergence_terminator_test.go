@@ -0,0 +1,66 @@
+package terminators
+
+import (
+	"testing"
+
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestConvergenceTerminatorIsBuildingTerminated(test *testing.T) {
+	root := &tree.Node{}
+	terminator := NewConvergenceTerminator(root, 0.05, 2)
+	if terminator.IsBuildingTerminated(0) {
+		test.Fail()
+	}
+
+	for pass, data := range []struct {
+		states []tree.NodeState
+		want   bool
+	}{
+		{
+			states: []tree.NodeState{{GameCount: 0}, {GameCount: 1}},
+			want:   false,
+		},
+		{
+			states: []tree.NodeState{
+				{GameCount: 1, WinCount: 1},
+				{GameCount: 1},
+			},
+			want: false,
+		},
+		{
+			states: []tree.NodeState{
+				{GameCount: 10, WinCount: 5},
+				{GameCount: 10, WinCount: 2},
+			},
+			want: false,
+		},
+		{
+			states: []tree.NodeState{
+				{GameCount: 40, WinCount: 21},
+				{GameCount: 40, WinCount: 8},
+			},
+			want: false,
+		},
+		{
+			states: []tree.NodeState{
+				{GameCount: 60, WinCount: 31},
+				{GameCount: 60, WinCount: 12},
+			},
+			want: true,
+		},
+	} {
+		root.Children = nil
+		for _, state := range data.states {
+			root.Children = append(root.Children, &tree.Node{
+				Move:  newTestMove(len(root.Children)),
+				State: state,
+			})
+		}
+
+		got := terminator.IsBuildingTerminated(pass + 1)
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,35 @@
+package terminators
+
+import (
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// GameCountTerminator ...
+//
+// It terminates building when the count of games simulated since the creation
+// of the terminator reaches the maximum. The count is taken from the root
+// state, so it doesn't depend on how many games are simulated per a pass.
+//
+type GameCountTerminator struct {
+	root             *tree.Node
+	initialGameCount int
+	maximalGameCount int
+}
+
+// NewGameCountTerminator ...
+func NewGameCountTerminator(
+	root *tree.Node,
+	maximalGameCount int,
+) GameCountTerminator {
+	return GameCountTerminator{
+		root:             root,
+		initialGameCount: root.State.GameCount,
+		maximalGameCount: maximalGameCount,
+	}
+}
+
+// IsBuildingTerminated ...
+func (terminator GameCountTerminator) IsBuildingTerminated(pass int) bool {
+	gameCount := terminator.root.State.GameCount - terminator.initialGameCount
+	return gameCount >= terminator.maximalGameCount
+}
@@ -0,0 +1,39 @@
+package terminators
+
+import (
+	"testing"
+
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestGameCountTerminatorIsBuildingTerminated(test *testing.T) {
+	type data struct {
+		gameCount int
+		want      bool
+	}
+
+	for _, data := range []data{
+		{
+			gameCount: 14,
+			want:      false,
+		},
+		{
+			gameCount: 15,
+			want:      true,
+		},
+		{
+			gameCount: 16,
+			want:      true,
+		},
+	} {
+		root := &tree.Node{State: tree.NodeState{GameCount: 5}}
+		terminator := NewGameCountTerminator(root, 10)
+
+		root.State.GameCount = data.gameCount
+		got := terminator.IsBuildingTerminated(1)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,90 @@
+package terminators
+
+import (
+	"time"
+
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// LeadTerminator ...
+//
+// It terminates building when the lead of the most visited root child over
+// the second one can't be overcome in the remaining budget.
+//
+// The remaining game count is estimated by the game count simulated since
+// the creation of the terminator and the share of the budget spent on it.
+//
+type LeadTerminator struct {
+	root             *tree.Node
+	initialGameCount int
+	remainingRatio   func(pass int) float64
+}
+
+// NewPassLeadTerminator ...
+//
+// The budget is the maximal pass.
+//
+func NewPassLeadTerminator(root *tree.Node, maximalPass int) LeadTerminator {
+	return LeadTerminator{
+		root:             root,
+		initialGameCount: root.State.GameCount,
+		remainingRatio: func(pass int) float64 {
+			return float64(maximalPass-pass) / float64(pass)
+		},
+	}
+}
+
+// NewTimeLeadTerminator ...
+//
+// The budget is the maximal duration.
+//
+func NewTimeLeadTerminator(
+	root *tree.Node,
+	clock Clock,
+	maximalDuration time.Duration,
+) LeadTerminator {
+	startTime := clock()
+	return LeadTerminator{
+		root:             root,
+		initialGameCount: root.State.GameCount,
+		remainingRatio: func(pass int) float64 {
+			elapsedTime := clock().Sub(startTime)
+			return float64(maximalDuration-elapsedTime) / float64(elapsedTime)
+		},
+	}
+}
+
+// IsBuildingTerminated ...
+func (terminator LeadTerminator) IsBuildingTerminated(pass int) bool {
+	spentGameCount := terminator.root.State.GameCount -
+		terminator.initialGameCount
+	if pass == 0 || spentGameCount <= 0 {
+		return false
+	}
+
+	remainingRatio := terminator.remainingRatio(pass)
+	if remainingRatio < 0 {
+		remainingRatio = 0
+	}
+
+	firstCount, secondCount := topGameCounts(terminator.root)
+	if firstCount == 0 {
+		return false
+	}
+
+	remainingGameCount := float64(spentGameCount) * remainingRatio
+	return float64(firstCount-secondCount) > remainingGameCount
+}
+
+func topGameCounts(root *tree.Node) (firstCount int, secondCount int) {
+	for _, child := range root.Children {
+		gameCount := child.State.GameCount
+		if gameCount > firstCount {
+			firstCount, secondCount = gameCount, firstCount
+		} else if gameCount > secondCount {
+			secondCount = gameCount
+		}
+	}
+
+	return firstCount, secondCount
+}
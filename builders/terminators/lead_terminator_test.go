@@ -0,0 +1,97 @@
+package terminators
+
+import (
+	"testing"
+	"time"
+
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestPassLeadTerminatorIsBuildingTerminated(test *testing.T) {
+	type args struct {
+		pass int
+	}
+	type data struct {
+		args      args
+		gameCount int
+		want      bool
+	}
+
+	for _, data := range []data{
+		{
+			args:      args{0},
+			gameCount: 100,
+			want:      false,
+		},
+		// 40 games per 4 passes, so 60 games remain for 6 passes
+		{
+			args:      args{4},
+			gameCount: 100,
+			want:      true,
+		},
+		// 10 games per a pass, so 90 games remain for 9 passes
+		{
+			args:      args{1},
+			gameCount: 70,
+			want:      false,
+		},
+		{
+			args:      args{10},
+			gameCount: 100,
+			want:      true,
+		},
+	} {
+		root := &tree.Node{State: tree.NodeState{GameCount: 60}}
+		terminator := NewPassLeadTerminator(root, 10)
+
+		root.State.GameCount = data.gameCount
+		root.Children = tree.NodeGroup{
+			&tree.Node{State: tree.NodeState{GameCount: 80}},
+			&tree.Node{State: tree.NodeState{GameCount: 5}},
+			&tree.Node{State: tree.NodeState{GameCount: 15}},
+		}
+		got := terminator.IsBuildingTerminated(data.args.pass)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestTimeLeadTerminatorIsBuildingTerminated(test *testing.T) {
+	type data struct {
+		elapsedTime time.Duration
+		want        bool
+	}
+
+	for _, data := range []data{
+		// 40 games per 4 seconds, so 60 games remain for 6 seconds
+		{
+			elapsedTime: 4 * time.Second,
+			want:        true,
+		},
+		// 40 games per 2 seconds, so 160 games remain for 8 seconds
+		{
+			elapsedTime: 2 * time.Second,
+			want:        false,
+		},
+	} {
+		currentTime := clock()
+		fakeClock := func() time.Time { return currentTime }
+
+		root := &tree.Node{State: tree.NodeState{GameCount: 60}}
+		terminator := NewTimeLeadTerminator(root, fakeClock, 10*time.Second)
+
+		currentTime = currentTime.Add(data.elapsedTime)
+		root.State.GameCount = 100
+		root.Children = tree.NodeGroup{
+			&tree.Node{State: tree.NodeState{GameCount: 85}},
+			&tree.Node{State: tree.NodeState{GameCount: 15}},
+		}
+		got := terminator.IsBuildingTerminated(1)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,29 @@
+package terminators
+
+import (
+	"sync/atomic"
+)
+
+// ManualTerminator ...
+//
+// It terminates building after a call of the method Terminate(). It's safe
+// for concurrent use.
+//
+type ManualTerminator struct {
+	terminated int32
+}
+
+// NewManualTerminator ...
+func NewManualTerminator() *ManualTerminator {
+	return &ManualTerminator{}
+}
+
+// Terminate ...
+func (terminator *ManualTerminator) Terminate() {
+	atomic.StoreInt32(&terminator.terminated, 1)
+}
+
+// IsBuildingTerminated ...
+func (terminator *ManualTerminator) IsBuildingTerminated(pass int) bool {
+	return atomic.LoadInt32(&terminator.terminated) != 0
+}
@@ -0,0 +1,17 @@
+package terminators
+
+import (
+	"testing"
+)
+
+func TestManualTerminatorIsBuildingTerminated(test *testing.T) {
+	terminator := NewManualTerminator()
+	if terminator.IsBuildingTerminated(5) {
+		test.Fail()
+	}
+
+	terminator.Terminate()
+	if !terminator.IsBuildingTerminated(5) {
+		test.Fail()
+	}
+}
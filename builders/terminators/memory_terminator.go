@@ -0,0 +1,64 @@
+package terminators
+
+import (
+	"unsafe"
+
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// ApproximateStoneSize ...
+//
+// It's used for estimating of a storage size; a storage is considered
+// as having a stone in each point.
+//
+const ApproximateStoneSize = 24
+
+// MemoryTerminator ...
+//
+// It terminates building when the approximate memory size of the tree reaches
+// the maximum.
+//
+// It recounts the nodes only when the tree may reach the maximum,
+// see tree.NodeCounter.
+//
+type MemoryTerminator struct {
+	counter          *tree.NodeCounter
+	maximalNodeCount int
+}
+
+// NewMemoryTerminator ...
+//
+// The maximal memory is in bytes.
+//
+func NewMemoryTerminator(
+	root *tree.Node,
+	maximalMemory int,
+) MemoryTerminator {
+	// round up, so the maximal memory corresponds to the count of nodes
+	// that occupy it at least
+	nodeSize := EstimateNodeSize(root)
+	return MemoryTerminator{
+		counter:          tree.NewNodeCounter(root),
+		maximalNodeCount: (maximalMemory + nodeSize - 1) / nodeSize,
+	}
+}
+
+// IsBuildingTerminated ...
+func (terminator MemoryTerminator) IsBuildingTerminated(pass int) bool {
+	return terminator.counter.IsReached(pass, terminator.maximalNodeCount)
+}
+
+// EstimateNodeSize ...
+//
+// It returns the approximate size of a node in bytes, including a storage
+// and a reference to the node from its parent.
+//
+func EstimateNodeSize(node *tree.Node) int {
+	size := node.Storage.Size()
+	storageSize := size.Width * size.Height * ApproximateStoneSize
+
+	var nodeReference *tree.Node
+	return int(unsafe.Sizeof(*node)) +
+		int(unsafe.Sizeof(nodeReference)) +
+		storageSize
+}
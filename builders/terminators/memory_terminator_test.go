@@ -0,0 +1,43 @@
+package terminators
+
+import (
+	"testing"
+	"unsafe"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestEstimateNodeSize(test *testing.T) {
+	node := &tree.Node{
+		Storage: models.NewBoard(models.Size{Width: 3, Height: 3}),
+	}
+	got := EstimateNodeSize(node)
+
+	want := int(unsafe.Sizeof(*node)) + int(unsafe.Sizeof(node)) +
+		9*ApproximateStoneSize
+	if got != want {
+		test.Fail()
+	}
+}
+
+func TestMemoryTerminatorIsBuildingTerminated(test *testing.T) {
+	root := &tree.Node{
+		Storage: models.NewBoard(models.Size{Width: 3, Height: 3}),
+	}
+	root.Children = tree.NodeGroup{
+		&tree.Node{Parent: root},
+		&tree.Node{Parent: root},
+	}
+	nodeSize := EstimateNodeSize(root)
+
+	terminator := NewMemoryTerminator(root, 3*nodeSize+1)
+	if terminator.IsBuildingTerminated(1) {
+		test.Fail()
+	}
+
+	terminator = NewMemoryTerminator(root, 3*nodeSize)
+	if !terminator.IsBuildingTerminated(1) {
+		test.Fail()
+	}
+}
@@ -0,0 +1,34 @@
+package terminators
+
+import (
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// NodeCountTerminator ...
+//
+// It terminates building when the count of nodes in the tree reaches
+// the maximum.
+//
+// It recounts the nodes only when the tree may reach the maximum,
+// see tree.NodeCounter.
+//
+type NodeCountTerminator struct {
+	counter          *tree.NodeCounter
+	maximalNodeCount int
+}
+
+// NewNodeCountTerminator ...
+func NewNodeCountTerminator(
+	root *tree.Node,
+	maximalNodeCount int,
+) NodeCountTerminator {
+	return NodeCountTerminator{
+		counter:          tree.NewNodeCounter(root),
+		maximalNodeCount: maximalNodeCount,
+	}
+}
+
+// IsBuildingTerminated ...
+func (terminator NodeCountTerminator) IsBuildingTerminated(pass int) bool {
+	return terminator.counter.IsReached(pass, terminator.maximalNodeCount)
+}
@@ -0,0 +1,47 @@
+package terminators
+
+import (
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestNodeCountTerminatorIsBuildingTerminated(test *testing.T) {
+	type args struct {
+		maximalNodeCount int
+	}
+	type data struct {
+		args args
+		want bool
+	}
+
+	for _, data := range []data{
+		{
+			args: args{4},
+			want: false,
+		},
+		{
+			args: args{3},
+			want: true,
+		},
+		{
+			args: args{2},
+			want: true,
+		},
+	} {
+		root := &tree.Node{
+			Storage: models.NewBoard(models.Size{Width: 3, Height: 3}),
+		}
+		root.Children = tree.NodeGroup{
+			&tree.Node{Parent: root},
+			&tree.Node{Parent: root},
+		}
+		terminator := NewNodeCountTerminator(root, data.args.maximalNodeCount)
+		got := terminator.IsBuildingTerminated(1)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
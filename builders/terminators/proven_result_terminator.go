@@ -0,0 +1,71 @@
+package terminators
+
+import (
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// ProvenResultTerminator ...
+//
+// It terminates building when the result of the game is known for sure:
+//
+//   - the game is already finished in the root;
+//   - any root child wins immediately;
+//   - all root children lose immediately.
+//
+type ProvenResultTerminator struct {
+	root      *tree.Node
+	generator models.Generator
+
+	isChecked bool
+	isProven  bool
+}
+
+// NewProvenResultTerminator ...
+func NewProvenResultTerminator(
+	root *tree.Node,
+	generator models.Generator,
+) *ProvenResultTerminator {
+	return &ProvenResultTerminator{
+		root:      root,
+		generator: generator,
+	}
+}
+
+// IsBuildingTerminated ...
+func (terminator *ProvenResultTerminator) IsBuildingTerminated(
+	pass int,
+) bool {
+	if terminator.isChecked {
+		return terminator.isProven
+	}
+
+	root := terminator.root
+	_, err := terminator.generator.LegalMoves(root.Storage, root.Move)
+	if err != nil {
+		terminator.isChecked, terminator.isProven = true, true
+		return true
+	}
+	if len(root.Children) == 0 {
+		// the root isn't expanded yet
+		return false
+	}
+
+	isLoss := true
+	for _, child := range root.Children {
+		_, err := terminator.generator.LegalMoves(child.Storage, child.Move)
+		switch err {
+		case models.ErrAlreadyLoss:
+			// the opponent has already lost, so the child wins
+			terminator.isChecked, terminator.isProven = true, true
+			return true
+		case models.ErrAlreadyWin:
+		default:
+			isLoss = false
+		}
+	}
+
+	// children of the root don't change after its expanding
+	terminator.isChecked, terminator.isProven = true, isLoss
+	return isLoss
+}
@@ -0,0 +1,118 @@
+package terminators
+
+import (
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestProvenResultTerminatorIsBuildingTerminated(test *testing.T) {
+	type data struct {
+		root func() *tree.Node
+		want bool
+	}
+
+	for _, data := range []data{
+		// a finished game
+		{
+			root: func() *tree.Node {
+				// +-+-+
+				// |B|W|
+				// +-+-+
+				// |W| |
+				// +-+-+
+				return newTestNode(
+					models.Move{
+						Color: models.White,
+						Point: models.Point{Column: 0, Row: 1},
+					},
+					models.Move{
+						Color: models.Black,
+						Point: models.Point{Column: 0, Row: 0},
+					},
+					models.Move{
+						Color: models.White,
+						Point: models.Point{Column: 1, Row: 0},
+					},
+				)
+			},
+			want: true,
+		},
+		// a not expanded root
+		{
+			root: func() *tree.Node {
+				return newTestNode(models.NewPreliminaryMove(models.Black))
+			},
+			want: false,
+		},
+		// a winning child
+		{
+			root: func() *tree.Node {
+				// +-+-+
+				// |W|B|
+				// +-+-+
+				// | | |
+				// +-+-+
+				root := newTestNode(
+					models.Move{
+						Color: models.Black,
+						Point: models.Point{Column: 1, Row: 0},
+					},
+					models.Move{
+						Color: models.White,
+						Point: models.Point{Column: 0, Row: 0},
+					},
+				)
+				root.ExpandLeaf(models.MoveGenerator{})
+
+				return root
+			},
+			want: true,
+		},
+		// an unknown result
+		{
+			root: func() *tree.Node {
+				root := newTestNode(models.NewPreliminaryMove(models.Black))
+				root.ExpandLeaf(models.MoveGenerator{})
+
+				return root
+			},
+			want: false,
+		},
+	} {
+		root := data.root()
+		terminator := NewProvenResultTerminator(root, models.MoveGenerator{})
+		got := terminator.IsBuildingTerminated(1)
+
+		if got != data.want {
+			test.Fail()
+		}
+		if got := terminator.IsBuildingTerminated(2); got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func newTestNode(lastMove models.Move, stones ...models.Move) *tree.Node {
+	storage := models.NewBoard(models.Size{Width: 2, Height: 2})
+	for _, stone := range stones {
+		storage = storage.ApplyMove(stone)
+	}
+	if lastMove.Point != models.NilPoint {
+		storage = storage.ApplyMove(lastMove)
+	}
+
+	return &tree.Node{
+		Move:    lastMove,
+		Storage: storage,
+		State:   tree.NodeState{GameCount: 1},
+	}
+}
+
+func newTestMove(index int) models.Move {
+	return models.Move{
+		Color: models.Black,
+		Point: models.Point{Column: index, Row: 0},
+	}
+}
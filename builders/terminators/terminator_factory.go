@@ -0,0 +1,24 @@
+package terminators
+
+import (
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// TerminatorFactory ...
+type TerminatorFactory interface {
+	NewTerminator(root *tree.Node) BuildingTerminator
+}
+
+// TerminatorFactoryFunc ...
+//
+// It allows to use an ordinary function as a terminator factory, e.g.
+// for composing of terminators that observe the root.
+//
+type TerminatorFactoryFunc func(root *tree.Node) BuildingTerminator
+
+// NewTerminator ...
+func (factory TerminatorFactoryFunc) NewTerminator(
+	root *tree.Node,
+) BuildingTerminator {
+	return factory(root)
+}
@@ -0,0 +1,25 @@
+package terminators
+
+import (
+	"testing"
+
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestTerminatorFactoryFuncNewTerminator(test *testing.T) {
+	root := &tree.Node{}
+	factory := TerminatorFactoryFunc(
+		func(gotRoot *tree.Node) BuildingTerminator {
+			if gotRoot != root {
+				test.Fail()
+			}
+
+			return NewPassTerminator(5)
+		},
+	)
+	got := factory.NewTerminator(root)
+
+	if got != NewPassTerminator(5) {
+		test.Fail()
+	}
+}
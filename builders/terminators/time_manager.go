@@ -0,0 +1,217 @@
+package terminators
+
+import (
+	"sync"
+	"time"
+
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// ...
+const (
+	DefaultMinimalMoveCount     = 10
+	DefaultCriticalityThreshold = 0.1
+	DefaultExtensionFactor      = 2
+	DefaultSafetyFactor         = 0.9
+)
+
+// ClockSettings ...
+type ClockSettings struct {
+	MainTime       time.Duration
+	Increment      time.Duration
+	ByoYomiTime    time.Duration
+	ByoYomiPeriods int
+}
+
+// ClockState ...
+type ClockState struct {
+	MainTime       time.Duration
+	ByoYomiPeriods int
+	MoveNumber     int
+}
+
+// TimeManager ...
+//
+// It allocates a time budget for each move according to a game clock.
+//
+// The budget is extended up to ExtensionFactor times on critical positions,
+// i.e. when game counts of the best and the second best root children differ
+// by less than CriticalityThreshold of the former.
+//
+// It's safe for concurrent use.
+//
+type TimeManager struct {
+	Clock    Clock
+	Settings ClockSettings
+	// The expected count of the remaining moves of the player
+	// is never less than it.
+	MinimalMoveCount     int
+	CriticalityThreshold float64
+	ExtensionFactor      float64
+	// It's a share of the available time that can be actually spent.
+	SafetyFactor float64
+
+	locker sync.RWMutex
+	state  ClockState
+}
+
+// NewTimeManager ...
+func NewTimeManager(clock Clock, settings ClockSettings) *TimeManager {
+	return &TimeManager{
+		Clock:                clock,
+		Settings:             settings,
+		MinimalMoveCount:     DefaultMinimalMoveCount,
+		CriticalityThreshold: DefaultCriticalityThreshold,
+		ExtensionFactor:      DefaultExtensionFactor,
+		SafetyFactor:         DefaultSafetyFactor,
+
+		state: ClockState{
+			MainTime:       settings.MainTime,
+			ByoYomiPeriods: settings.ByoYomiPeriods,
+		},
+	}
+}
+
+// ClockState ...
+func (manager *TimeManager) ClockState() ClockState {
+	manager.locker.RLock()
+	defer manager.locker.RUnlock()
+
+	return manager.state
+}
+
+// SetClockState ...
+//
+// It should be called before each search with the actual state of the clock
+// of the searching player.
+//
+func (manager *TimeManager) SetClockState(state ClockState) {
+	manager.locker.Lock()
+	defer manager.locker.Unlock()
+
+	manager.state = state
+}
+
+// Budget ...
+//
+// It returns the usual budget for the next move and the maximal one
+// for critical positions.
+//
+func (manager *TimeManager) Budget(root *tree.Node) (
+	duration time.Duration,
+	maximalDuration time.Duration,
+) {
+	state := manager.ClockState()
+	settings := manager.Settings
+	if state.MainTime > 0 {
+		size := root.Storage.Size()
+		moveCount := (size.Width*size.Height - state.MoveNumber) / 2
+		if moveCount < manager.MinimalMoveCount {
+			moveCount = manager.MinimalMoveCount
+		}
+		if moveCount < 1 {
+			moveCount = 1
+		}
+
+		availableTime := state.MainTime + settings.Increment
+		if state.ByoYomiPeriods > 0 {
+			availableTime += settings.ByoYomiTime
+		}
+
+		duration = state.MainTime/time.Duration(moveCount) + settings.Increment
+		maximalDuration = scaleDuration(duration, manager.ExtensionFactor)
+		duration = minimalDuration(duration, availableTime)
+		maximalDuration = minimalDuration(maximalDuration, availableTime)
+	} else if state.ByoYomiPeriods > 0 {
+		duration = settings.ByoYomiTime
+		maximalDuration = duration
+		if state.ByoYomiPeriods > 1 {
+			// it's allowed to lose one period on a critical position
+			maximalDuration = minimalDuration(
+				scaleDuration(duration, manager.ExtensionFactor),
+				2*settings.ByoYomiTime,
+			)
+		}
+	} else {
+		duration = settings.Increment
+		maximalDuration = duration
+	}
+
+	duration = scaleDuration(duration, manager.SafetyFactor)
+	maximalDuration = scaleDuration(maximalDuration, manager.SafetyFactor)
+	if maximalDuration < duration {
+		maximalDuration = duration
+	}
+
+	return duration, maximalDuration
+}
+
+// NewTerminator ...
+//
+// It captures the start time on the call, so it should be called right before
+// the search.
+//
+func (manager *TimeManager) NewTerminator(
+	root *tree.Node,
+) BuildingTerminator {
+	duration, maximalDuration := manager.Budget(root)
+	return ClockTerminator{
+		clock:                manager.Clock,
+		root:                 root,
+		duration:             duration,
+		maximalDuration:      maximalDuration,
+		criticalityThreshold: manager.CriticalityThreshold,
+		startTime:            manager.Clock(),
+	}
+}
+
+// ClockTerminator ...
+//
+// It's created by TimeManager.
+//
+type ClockTerminator struct {
+	clock                Clock
+	root                 *tree.Node
+	duration             time.Duration
+	maximalDuration      time.Duration
+	criticalityThreshold float64
+	startTime            time.Time
+}
+
+// IsBuildingTerminated ...
+func (terminator ClockTerminator) IsBuildingTerminated(pass int) bool {
+	elapsedTime := terminator.clock().Sub(terminator.startTime)
+	if elapsedTime < terminator.duration {
+		return false
+	}
+	if elapsedTime >= terminator.maximalDuration {
+		return true
+	}
+
+	return !isCriticalPosition(terminator.root, terminator.criticalityThreshold)
+}
+
+func isCriticalPosition(root *tree.Node, threshold float64) bool {
+	firstCount, secondCount := topGameCounts(root)
+	if secondCount == 0 {
+		return false
+	}
+
+	difference := float64(firstCount-secondCount) / float64(firstCount)
+	return difference < threshold
+}
+
+func scaleDuration(duration time.Duration, factor float64) time.Duration {
+	return time.Duration(float64(duration) * factor)
+}
+
+func minimalDuration(
+	durationOne time.Duration,
+	durationTwo time.Duration,
+) time.Duration {
+	if durationOne < durationTwo {
+		return durationOne
+	}
+
+	return durationTwo
+}
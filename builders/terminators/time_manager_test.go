@@ -0,0 +1,227 @@
+package terminators
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestNewTimeManager(test *testing.T) {
+	settings := ClockSettings{
+		MainTime:       time.Minute,
+		ByoYomiTime:    10 * time.Second,
+		ByoYomiPeriods: 3,
+	}
+	manager := NewTimeManager(clock, settings)
+
+	if !reflect.DeepEqual(manager.Settings, settings) {
+		test.Fail()
+	}
+
+	wantState := ClockState{MainTime: time.Minute, ByoYomiPeriods: 3}
+	if manager.ClockState() != wantState {
+		test.Fail()
+	}
+}
+
+func TestTimeManagerBudget(test *testing.T) {
+	type fields struct {
+		settings ClockSettings
+		state    ClockState
+	}
+	type data struct {
+		fields              fields
+		wantDuration        time.Duration
+		wantMaximalDuration time.Duration
+	}
+
+	for _, data := range []data{
+		// main time: 81 - 21 = 60 points, 30 expected moves
+		{
+			fields: fields{
+				settings: ClockSettings{
+					MainTime:  10 * time.Minute,
+					Increment: 2 * time.Second,
+				},
+				state: ClockState{
+					MainTime:   5 * time.Minute,
+					MoveNumber: 21,
+				},
+			},
+			wantDuration:        10800 * time.Millisecond,
+			wantMaximalDuration: 21600 * time.Millisecond,
+		},
+		// main time: the minimal move count
+		{
+			fields: fields{
+				settings: ClockSettings{
+					MainTime: 10 * time.Minute,
+				},
+				state: ClockState{
+					MainTime:   100 * time.Second,
+					MoveNumber: 80,
+				},
+			},
+			wantDuration:        9 * time.Second,
+			wantMaximalDuration: 18 * time.Second,
+		},
+		// byo-yomi: the last period
+		{
+			fields: fields{
+				settings: ClockSettings{
+					ByoYomiTime:    10 * time.Second,
+					ByoYomiPeriods: 3,
+				},
+				state: ClockState{
+					ByoYomiPeriods: 1,
+				},
+			},
+			wantDuration:        9 * time.Second,
+			wantMaximalDuration: 9 * time.Second,
+		},
+		// byo-yomi: several periods
+		{
+			fields: fields{
+				settings: ClockSettings{
+					ByoYomiTime:    10 * time.Second,
+					ByoYomiPeriods: 3,
+				},
+				state: ClockState{
+					ByoYomiPeriods: 2,
+				},
+			},
+			wantDuration:        9 * time.Second,
+			wantMaximalDuration: 18 * time.Second,
+		},
+		// no time
+		{
+			fields: fields{
+				settings: ClockSettings{
+					Increment: 5 * time.Second,
+				},
+			},
+			wantDuration:        4500 * time.Millisecond,
+			wantMaximalDuration: 4500 * time.Millisecond,
+		},
+	} {
+		manager := NewTimeManager(clock, data.fields.settings)
+		manager.SetClockState(data.fields.state)
+
+		root := &tree.Node{
+			Storage: models.NewBoard(models.Size{Width: 9, Height: 9}),
+		}
+		gotDuration, gotMaximalDuration := manager.Budget(root)
+
+		if gotDuration != data.wantDuration {
+			test.Fail()
+		}
+		if gotMaximalDuration != data.wantMaximalDuration {
+			test.Fail()
+		}
+	}
+}
+
+func TestTimeManagerNewTerminator(test *testing.T) {
+	manager := NewTimeManager(clock, ClockSettings{Increment: 5 * time.Second})
+	root := &tree.Node{
+		Storage: models.NewBoard(models.Size{Width: 9, Height: 9}),
+	}
+	got := manager.NewTerminator(root)
+
+	want := ClockTerminator{
+		clock:                clock,
+		root:                 root,
+		duration:             4500 * time.Millisecond,
+		maximalDuration:      4500 * time.Millisecond,
+		criticalityThreshold: DefaultCriticalityThreshold,
+		startTime:            clock(),
+	}
+	gotTerminator, ok := got.(ClockTerminator)
+	if !ok {
+		test.FailNow()
+	}
+
+	gotClock := reflect.ValueOf(gotTerminator.clock).Pointer()
+	wantClock := reflect.ValueOf(want.clock).Pointer()
+	if gotClock != wantClock {
+		test.Fail()
+	}
+
+	gotTerminator.clock, want.clock = nil, nil
+	if !reflect.DeepEqual(gotTerminator, want) {
+		test.Fail()
+	}
+}
+
+func TestClockTerminatorIsBuildingTerminated(test *testing.T) {
+	type fields struct {
+		root      *tree.Node
+		startTime time.Time
+	}
+	type data struct {
+		fields fields
+		want   bool
+	}
+
+	criticalRoot := &tree.Node{
+		Children: tree.NodeGroup{
+			&tree.Node{State: tree.NodeState{GameCount: 10}},
+			&tree.Node{State: tree.NodeState{GameCount: 95}},
+			&tree.Node{State: tree.NodeState{GameCount: 100}},
+		},
+	}
+	settledRoot := &tree.Node{
+		Children: tree.NodeGroup{
+			&tree.Node{State: tree.NodeState{GameCount: 10}},
+			&tree.Node{State: tree.NodeState{GameCount: 50}},
+			&tree.Node{State: tree.NodeState{GameCount: 100}},
+		},
+	}
+	for _, data := range []data{
+		{
+			fields: fields{
+				root:      criticalRoot,
+				startTime: clock().Add(-4 * time.Second),
+			},
+			want: false,
+		},
+		{
+			fields: fields{
+				root:      settledRoot,
+				startTime: clock().Add(-6 * time.Second),
+			},
+			want: true,
+		},
+		{
+			fields: fields{
+				root:      criticalRoot,
+				startTime: clock().Add(-6 * time.Second),
+			},
+			want: false,
+		},
+		{
+			fields: fields{
+				root:      criticalRoot,
+				startTime: clock().Add(-10 * time.Second),
+			},
+			want: true,
+		},
+	} {
+		terminator := ClockTerminator{
+			clock:                clock,
+			root:                 data.fields.root,
+			duration:             5 * time.Second,
+			maximalDuration:      10 * time.Second,
+			criticalityThreshold: 0.1,
+			startTime:            data.fields.startTime,
+		}
+		got := terminator.IsBuildingTerminated(5)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
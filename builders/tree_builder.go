@@ -2,6 +2,8 @@ package builders
 
 import (
 	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/observers"
+	"github.com/thewizardplusplus/go-atari-montecarlo/symmetries"
 	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
 )
 
@@ -13,18 +15,55 @@ type BulkySimulator interface {
 
 // TreeBuilder ...
 type TreeBuilder struct {
+	// If it implements tree.RootedNodeSelector, it's bound to the passed root.
 	NodeSelector  tree.NodeSelector
 	MoveGenerator models.Generator
 	Simulator     BulkySimulator
+	// If it's nil, nodes are allocated in the usual way.
+	NodePool *tree.NodePool
+	// If it's set, leaves at the maximal depth from the root aren't expanded,
+	// e.g. for weaker play.
+	MaximalDepth int
+	// If it's set, leaves at depths less than it are expanded with one move
+	// per class of symmetric moves (see symmetries.ReducingGenerator),
+	// e.g. one for the root only.
+	SymmetryDepth int
+	// If it's nil, observers.NopObserver is used.
+	Observer observers.Observer
 }
 
 // Pass ...
 func (builder TreeBuilder) Pass(root *tree.Node) {
-	leaves := root.
-		SelectLeaf(builder.NodeSelector).
-		ExpandLeaf(builder.MoveGenerator)
+	observer := observers.OrNop(builder.Observer)
+
+	selector := builder.NodeSelector
+	if rootedSelector, ok := selector.(tree.RootedNodeSelector); ok {
+		selector = rootedSelector.WithRoot(root)
+	}
+
+	leaf := root.SelectLeaf(selector)
+	observer.OnLeafSelection(leaf)
+
+	// depths are counted from the passed root, even if it has a parent
+	depth := leaf.Depth(root)
+	leaves := tree.NodeGroup{leaf}
+	if builder.MaximalDepth == 0 || depth < builder.MaximalDepth {
+		generator := builder.MoveGenerator
+		if depth < builder.SymmetryDepth {
+			generator = symmetries.ReducingGenerator{Generator: generator}
+		}
+
+		leaves = leaf.ExpandLeafInPool(generator, builder.NodePool)
+	}
+	if len(leaf.Children) != 0 {
+		observer.OnLeafExpansion(leaf, leaves)
+	}
+
 	states := builder.Simulator.Simulate(leaves)
 	for index, state := range states {
-		leaves[index].UpdateState(state.Invert())
+		state = state.Invert()
+		observer.OnSimulation(leaves[index], state)
+
+		leaves[index].UpdateState(state)
 	}
 }
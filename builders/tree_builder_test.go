@@ -20,6 +20,22 @@ func (selector MockNodeSelector) SelectNode(nodes tree.NodeGroup) *tree.Node {
 	return selector.selectNode(nodes)
 }
 
+type MockRootedNodeSelector struct {
+	MockNodeSelector
+
+	withRoot func(root *tree.Node) tree.NodeSelector
+}
+
+func (selector MockRootedNodeSelector) WithRoot(
+	root *tree.Node,
+) tree.NodeSelector {
+	if selector.withRoot == nil {
+		panic("not implemented")
+	}
+
+	return selector.withRoot(root)
+}
+
 type MockBulkySimulator struct {
 	simulate func(nodes tree.NodeGroup) []tree.NodeState
 }
@@ -421,3 +437,180 @@ func TestTreeBuilderPass(test *testing.T) {
 		}
 	}
 }
+
+func TestTreeBuilderPass_withObserver(test *testing.T) {
+	type data struct {
+		root       *tree.Node
+		wantEvents []string
+	}
+
+	for _, data := range []data{
+		{
+			root: &tree.Node{
+				Move:    models.NewPreliminaryMove(models.Black),
+				Storage: models.NewBoard(models.Size{Width: 2, Height: 1}),
+			},
+			wantEvents: []string{
+				"leaf selection {-1 -1}",
+				"simulation {-1 -1} {GameCount:1 WinCount:0}",
+			},
+		},
+		{
+			root: &tree.Node{
+				Move:    models.NewPreliminaryMove(models.Black),
+				Storage: models.NewBoard(models.Size{Width: 2, Height: 1}),
+				State:   tree.NodeState{GameCount: 1, WinCount: 1},
+			},
+			wantEvents: []string{
+				"leaf selection {-1 -1}",
+				"leaf expansion 2",
+				"simulation {0 0} {GameCount:1 WinCount:0}",
+			},
+		},
+	} {
+		var events []string
+		builder := TreeBuilder{
+			NodeSelector: MockNodeSelector{
+				selectNode: func(nodes tree.NodeGroup) *tree.Node { return nodes[0] },
+			},
+			MoveGenerator: models.MoveGenerator{},
+			Simulator: MockBulkySimulator{
+				simulate: func(nodes tree.NodeGroup) []tree.NodeState {
+					return []tree.NodeState{{GameCount: 1, WinCount: 1}}
+				},
+			},
+			Observer: RecordingObserver{events: &events},
+		}
+		builder.Pass(data.root)
+
+		if !reflect.DeepEqual(events, data.wantEvents) {
+			test.Fail()
+		}
+	}
+}
+
+func TestTreeBuilderPass_withRootedNodeSelector(test *testing.T) {
+	root := &tree.Node{
+		Parent:  &tree.Node{},
+		Move:    models.NewPreliminaryMove(models.Black),
+		Storage: models.NewBoard(models.Size{Width: 3, Height: 3}),
+	}
+
+	var boundRoot *tree.Node
+	builder := TreeBuilder{
+		NodeSelector: MockRootedNodeSelector{
+			withRoot: func(root *tree.Node) tree.NodeSelector {
+				boundRoot = root
+				return MockNodeSelector{}
+			},
+		},
+		MoveGenerator: models.MoveGenerator{},
+		Simulator: MockBulkySimulator{
+			simulate: func(nodes tree.NodeGroup) []tree.NodeState {
+				return make([]tree.NodeState, len(nodes))
+			},
+		},
+	}
+	builder.Pass(root)
+
+	if boundRoot != root {
+		test.Fail()
+	}
+}
+
+func TestTreeBuilderPass_withMaximalDepth(test *testing.T) {
+	type data struct {
+		maximalDepth     int
+		rootParent       *tree.Node
+		wantChildCount   int
+		wantSimulatedTop bool
+	}
+
+	for _, data := range []data{
+		{maximalDepth: 0, wantChildCount: 8, wantSimulatedTop: false},
+		{maximalDepth: 1, wantChildCount: 0, wantSimulatedTop: true},
+		{maximalDepth: 2, wantChildCount: 8, wantSimulatedTop: false},
+		{
+			maximalDepth:     2,
+			rootParent:       &tree.Node{},
+			wantChildCount:   8,
+			wantSimulatedTop: false,
+		},
+	} {
+		root := &tree.Node{
+			Parent:  data.rootParent,
+			Move:    models.NewPreliminaryMove(models.Black),
+			Storage: models.NewBoard(models.Size{Width: 3, Height: 3}),
+			State:   tree.NodeState{GameCount: 2, WinCount: 1},
+		}
+		child := &tree.Node{
+			Parent: root,
+			Move: models.Move{
+				Color: models.Black,
+				Point: models.Point{Column: 1, Row: 1},
+			},
+			State: tree.NodeState{GameCount: 1, WinCount: 1},
+		}
+		child.Storage = root.Storage.ApplyMove(child.Move)
+		root.Children = tree.NodeGroup{child}
+
+		var simulatedNodes tree.NodeGroup
+		builder := TreeBuilder{
+			NodeSelector: MockNodeSelector{
+				selectNode: func(nodes tree.NodeGroup) *tree.Node { return nodes[0] },
+			},
+			MoveGenerator: models.MoveGenerator{},
+			Simulator: MockBulkySimulator{
+				simulate: func(nodes tree.NodeGroup) []tree.NodeState {
+					simulatedNodes = nodes
+					return nil
+				},
+			},
+			MaximalDepth: data.maximalDepth,
+		}
+		builder.Pass(root)
+
+		if len(child.Children) != data.wantChildCount {
+			test.Fail()
+		}
+		if (simulatedNodes[0] == child) != data.wantSimulatedTop {
+			test.Fail()
+		}
+	}
+}
+
+func TestTreeBuilderPass_withSymmetryDepth(test *testing.T) {
+	type data struct {
+		symmetryDepth  int
+		rootParent     *tree.Node
+		wantChildCount int
+	}
+
+	for _, data := range []data{
+		{symmetryDepth: 0, wantChildCount: 9},
+		{symmetryDepth: 1, wantChildCount: 3},
+		{symmetryDepth: 1, rootParent: &tree.Node{}, wantChildCount: 3},
+	} {
+		root := &tree.Node{
+			Parent:  data.rootParent,
+			Move:    models.NewPreliminaryMove(models.Black),
+			Storage: models.NewBoard(models.Size{Width: 3, Height: 3}),
+			State:   tree.NodeState{GameCount: 1},
+		}
+		builder := TreeBuilder{
+			NodeSelector:  MockNodeSelector{},
+			MoveGenerator: models.MoveGenerator{},
+			Simulator: MockBulkySimulator{
+				simulate: func(nodes tree.NodeGroup) []tree.NodeState {
+					return nil
+				},
+			},
+			SymmetryDepth: data.symmetryDepth,
+		}
+		builder.Pass(root)
+
+		if len(root.Children) != data.wantChildCount {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"log"
+	"os"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/book"
+	"github.com/thewizardplusplus/go-atari-montecarlo/configs"
+	"github.com/thewizardplusplus/go-atari-montecarlo/selfplay"
+)
+
+func main() {
+	configPath := flag.String("config", "", "path to a searcher config in JSON")
+	mode := flag.String("mode", "search", "generation mode: search or selfplay")
+	maximalDepth := flag.Int("depth", 4, "maximal depth of book positions")
+	branchingFactor := flag.Int("branching", 3, "count of searched children")
+	minimalGameCount := flag.Int("minimalGames", 1, "minimal game count")
+	gameCount := flag.Int("games", 100, "count of self-play games")
+	openingMoveCount := flag.Int("openingMoves", 2, "count of random moves")
+	seed := flag.Int64("seed", 1, "seed of a randomizer")
+	boardWidth := flag.Int("width", 5, "board width")
+	boardHeight := flag.Int("height", 5, "board height")
+	inputPath := flag.String("input", "", "path to a book to extend")
+	outputPath := flag.String("output", "book.bin", "path to a book")
+	flag.Parse()
+
+	config := configs.Config{UCBFactor: 1, MaximalPass: 1000}
+	if *configPath != "" {
+		data, err := ioutil.ReadFile(*configPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := json.Unmarshal(data, &config); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	searcher, err := config.NewSearcher()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	openingBook := book.NewBook()
+	if *inputPath != "" {
+		openingBook = readBook(*inputPath)
+	}
+
+	size := models.Size{Width: *boardWidth, Height: *boardHeight}
+	switch *mode {
+	case "search":
+		generator := book.SearchGenerator{
+			Searcher:         searcher,
+			MaximalDepth:     *maximalDepth,
+			BranchingFactor:  *branchingFactor,
+			MinimalGameCount: *minimalGameCount,
+		}
+		err = generator.Generate(
+			openingBook,
+			models.NewBoard(size),
+			models.NewPreliminaryMove(models.Black),
+		)
+	case "selfplay":
+		generator := book.SelfPlayGenerator{
+			Game: selfplay.Game{
+				MoveGenerator:    models.MoveGenerator{},
+				Size:             size,
+				OpeningMoveCount: *openingMoveCount,
+			},
+			GameCount:    *gameCount,
+			MaximalDepth: *maximalDepth,
+			Seed:         *seed,
+		}
+		err = generator.Generate(openingBook, searcher, searcher)
+	default:
+		log.Fatalf("unknown mode %q", *mode)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	file, err := os.Create(*outputPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := openingBook.Encode(file); err != nil {
+		log.Fatal(err)
+	}
+	if err := file.Close(); err != nil {
+		log.Fatal(err)
+	}
+
+	log.Printf("%d positions are stored", openingBook.PositionCount())
+}
+
+func readBook(path string) *book.Book {
+	file, err := os.Open(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer file.Close() // nolint: errcheck
+
+	openingBook, err := book.Decode(file)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	return openingBook
+}
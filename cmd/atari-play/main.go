@@ -0,0 +1,380 @@
+package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/configs"
+	"github.com/thewizardplusplus/go-atari-montecarlo/diagrams"
+	"github.com/thewizardplusplus/go-atari-montecarlo/levels"
+	"github.com/thewizardplusplus/go-atari-montecarlo/records"
+	"github.com/thewizardplusplus/go-atari-montecarlo/searchers"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+const (
+	// like in Go, the letter "I" is skipped
+	columnLetters = "ABCDEFGHJKLMNOPQRSTUVWXYZ"
+	helpMessage   = `commands:
+  <column><row>  play a move, e.g. C3
+  hint           show the best moves by the engine
+  undo           take back your last move and the engine reply
+  resign         resign the game
+  help           show this message`
+)
+
+var (
+	errInvalidPoint = errors.New("invalid point")
+	errIllegalMove  = errors.New("illegal move")
+)
+
+func main() {
+	boardWidth := flag.Int("width", 5, "board width")
+	boardHeight := flag.Int("height", 5, "board height")
+	humanColorName := flag.String("color", "black", "color of the human player")
+	levelName := flag.String("level", "beginner", "difficulty level")
+	configPath := flag.String(
+		"config",
+		"",
+		"path to an engine config in JSON (it overrides the level)",
+	)
+	hintLevelName := flag.String("hintLevel", "expert", "level of hints")
+	hintCount := flag.Int("hints", 3, "count of moves shown by a hint")
+	recordPath := flag.String("record", "game.sgf", "path to a game record")
+	seed := flag.Int64(
+		"seed",
+		0,
+		"seed of a randomizer (if it's zero, the current time is used)",
+	)
+	flag.Parse()
+
+	size := models.Size{Width: *boardWidth, Height: *boardHeight}
+	if size.Width < 1 || size.Width > len(columnLetters) ||
+		size.Height < 1 || size.Height > len(columnLetters) {
+		log.Fatalf("board sides should be from 1 to %d", len(columnLetters))
+	}
+
+	var humanColor models.Color
+	switch *humanColorName {
+	case "black":
+		humanColor = models.Black
+	case "white":
+		humanColor = models.White
+	default:
+		log.Fatalf("unknown color %q", *humanColorName)
+	}
+
+	engineName := "atari-montecarlo (" + *levelName + ")"
+	config, err := findLevelConfig(*levelName)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if *configPath != "" {
+		engineName = "atari-montecarlo (" + *configPath + ")"
+		data, err := ioutil.ReadFile(*configPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := json.Unmarshal(data, &config); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	if *seed == 0 {
+		*seed = time.Now().UnixNano()
+	}
+
+	searcher, err := config.NewSeededSearcher(*seed)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	hintConfig, err := findLevelConfig(*hintLevelName)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	// hints don't repeat random choices of the engine
+	hintSearcher, err := hintConfig.NewSeededSearcher(*seed + 1)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	game := newGame(size, humanColor)
+	record, playErr := game.play(searcher, hintSearcher, *hintCount)
+	record.BlackPlayer, record.WhitePlayer = "human", engineName
+	if humanColor == models.White {
+		record.BlackPlayer, record.WhitePlayer = engineName, "human"
+	}
+
+	// the record is written even if the game failed, so it isn't lost
+	if err := writeRecord(*recordPath, record); err != nil {
+		log.Fatal(err)
+	}
+
+	fmt.Printf("the game is saved to %s\n", *recordPath)
+	if playErr != nil {
+		log.Fatal(playErr)
+	}
+}
+
+func writeRecord(path string, record records.Record) error {
+	recordFile, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer recordFile.Close() // nolint: errcheck
+
+	if err := record.WriteSGF(recordFile); err != nil {
+		return err
+	}
+
+	return recordFile.Close()
+}
+
+func findLevelConfig(name string) (configs.Config, error) {
+	level, ok := levels.FindLevel(name)
+	if !ok {
+		var names []string
+		for _, level := range levels.Levels() {
+			names = append(names, level.Name)
+		}
+
+		return configs.Config{}, fmt.Errorf(
+			"unknown level %q (available levels: %s)",
+			name,
+			strings.Join(names, ", "),
+		)
+	}
+
+	return level.Config, nil
+}
+
+type game struct {
+	generator  models.Generator
+	size       models.Size
+	humanColor models.Color
+	// storages[i] is a storage before moves[i]
+	storages []models.StoneStorage
+	moves    []models.Move
+}
+
+func newGame(size models.Size, humanColor models.Color) *game {
+	return &game{
+		generator:  models.MoveGenerator{},
+		size:       size,
+		humanColor: humanColor,
+		storages:   []models.StoneStorage{models.NewBoard(size)},
+	}
+}
+
+func (game *game) position() (models.StoneStorage, models.Move) {
+	storage := game.storages[len(game.storages)-1]
+	if len(game.moves) == 0 {
+		return storage, models.NewPreliminaryMove(models.Black)
+	}
+
+	return storage, game.moves[len(game.moves)-1]
+}
+
+func (game *game) applyMove(move models.Move) {
+	storage, _ := game.position()
+	game.storages = append(game.storages, storage.ApplyMove(move))
+	game.moves = append(game.moves, move)
+}
+
+// it takes back the last move of the human and the engine reply to it
+func (game *game) undo() bool {
+	for index := len(game.moves) - 1; index >= 0; index-- {
+		if game.moves[index].Color == game.humanColor {
+			game.storages = game.storages[:index+1]
+			game.moves = game.moves[:index]
+
+			return true
+		}
+	}
+
+	return false
+}
+
+// it plays until the game is over, the human resigns, the input ends or
+// an error occurs; in the latter case, the record of the played moves
+// is returned too
+func (game *game) play(
+	searcher searchers.MoveSearcher,
+	hintSearcher searchers.MoveSearcher,
+	hintCount int,
+) (records.Record, error) {
+	record := records.Record{Size: game.size}
+	fmt.Println(helpMessage)
+
+	scanner := bufio.NewScanner(os.Stdin)
+loop:
+	for {
+		storage, previousMove := game.position()
+		color := previousMove.Color.Negative()
+		legalMoves, err := game.generator.LegalMoves(storage, previousMove)
+		if err != nil {
+			fmt.Print(game.render())
+
+			winner := color
+			if err == models.ErrAlreadyLoss {
+				winner = color.Negative()
+			}
+			record.IsFinished, record.Winner = true, winner
+			fmt.Printf("%s wins\n", colorName(winner))
+
+			break loop
+		}
+
+		root := &tree.Node{Move: previousMove, Storage: storage}
+		if color != game.humanColor {
+			node, err := searcher.SearchMove(root)
+			if err != nil {
+				record.Moves = game.moves
+				return record, err
+			}
+
+			game.applyMove(node.Move)
+			fmt.Printf("the engine plays %s\n", game.formatPoint(node.Move.Point))
+
+			continue
+		}
+
+		fmt.Print(game.render())
+		fmt.Printf("%s> ", colorName(color))
+		if !scanner.Scan() {
+			fmt.Println()
+			break loop
+		}
+
+		command := strings.TrimSpace(scanner.Text())
+		switch strings.ToLower(command) {
+		case "":
+		case "help":
+			fmt.Println(helpMessage)
+		case "hint":
+			variations, err := hintSearcher.SearchVariations(root, hintCount)
+			if err != nil {
+				record.Moves = game.moves
+				return record, err
+			}
+
+			for _, variation := range variations {
+				var line []string
+				for _, move := range variation.PrincipalVariation {
+					line = append(line, game.formatPoint(move.Point))
+				}
+
+				fmt.Printf(
+					"  %s: win rate %.1f%% (%.1f%%-%.1f%%), %d games, line %s\n",
+					game.formatPoint(variation.Move.Point),
+					100*variation.State.WinRate(),
+					100*variation.LowerBound,
+					100*variation.UpperBound,
+					variation.State.GameCount,
+					strings.Join(line, " "),
+				)
+			}
+		case "undo":
+			if !game.undo() {
+				fmt.Println("there is nothing to undo")
+			}
+		case "resign":
+			record.IsFinished, record.Winner = true, color.Negative()
+			record.IsResignation = true
+			fmt.Printf("%s resigns\n", colorName(color))
+
+			break loop
+		default:
+			point, err := game.parsePoint(command)
+			if err == nil {
+				move := models.Move{Color: color, Point: point}
+				err = errIllegalMove
+				for _, legalMove := range legalMoves {
+					if legalMove == move {
+						game.applyMove(move)
+						err = nil
+
+						break
+					}
+				}
+			}
+			if err != nil {
+				fmt.Printf("%s; type \"help\" for commands\n", err)
+			}
+		}
+	}
+
+	record.Moves = game.moves
+	return record, scanner.Err()
+}
+
+// it renders the diagram of the position with coordinates; rows are numbered
+// from the bottom, like in Go
+func (game *game) render() string {
+	storage, previousMove := game.position()
+	diagram := diagrams.Render(storage, previousMove)
+	rows := strings.Split(strings.TrimSpace(diagram), "\n")[1:]
+
+	letters := strings.Split(columnLetters[:game.size.Width], "")
+	header := "   " + strings.Join(letters, " ")
+
+	var builder strings.Builder
+	builder.WriteString(header + "\n")
+	for index, row := range rows {
+		number := game.size.Height - index
+		fmt.Fprintf( // nolint: errcheck
+			&builder,
+			"%2d %s %d\n",
+			number,
+			row,
+			number,
+		)
+	}
+	builder.WriteString(header + "\n")
+
+	return builder.String()
+}
+
+func (game *game) formatPoint(point models.Point) string {
+	row := game.size.Height - point.Row
+	return string(columnLetters[point.Column]) + strconv.Itoa(row)
+}
+
+func (game *game) parsePoint(text string) (models.Point, error) {
+	if len(text) < 2 {
+		return models.Point{}, errInvalidPoint
+	}
+
+	column := strings.IndexByte(columnLetters, strings.ToUpper(text)[0])
+	row, err := strconv.Atoi(text[1:])
+	if err != nil {
+		return models.Point{}, errInvalidPoint
+	}
+
+	point := models.Point{Column: column, Row: game.size.Height - row}
+	if column == -1 || !game.size.HasPoint(point) {
+		return models.Point{}, errInvalidPoint
+	}
+
+	return point, nil
+}
+
+func colorName(color models.Color) string {
+	if color == models.Black {
+		return "black"
+	}
+
+	return "white"
+}
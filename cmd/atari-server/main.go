@@ -0,0 +1,113 @@
+package main
+
+import (
+	"encoding/json"
+	"expvar"
+	"flag"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"runtime"
+	"time"
+
+	"github.com/thewizardplusplus/go-atari-montecarlo/configs"
+	"github.com/thewizardplusplus/go-atari-montecarlo/metrics"
+	"github.com/thewizardplusplus/go-atari-montecarlo/server"
+)
+
+func main() {
+	address := flag.String("address", ":8080", "address to listen on")
+	configPath := flag.String("config", "", "path to a base config in JSON")
+	concurrency := flag.Int(
+		"concurrency",
+		runtime.NumCPU(),
+		"maximal count of parallel searches",
+	)
+	timeout := flag.Duration("timeout", 10*time.Second, "request timeout")
+	analysisTimeout := flag.Duration(
+		"analysisTimeout",
+		time.Minute,
+		"maximal duration of a streaming analysis",
+	)
+	maximalBoardSide := flag.Int(
+		"maximalBoardSide",
+		server.DefaultMaximalBoardSide,
+		"maximal board side",
+	)
+	maximalBuilderConcurrency := flag.Int(
+		"maximalBuilderConcurrency",
+		runtime.NumCPU(),
+		"maximal builder concurrency of a config",
+	)
+	maximalSimulatorConcurrency := flag.Int(
+		"maximalSimulatorConcurrency",
+		runtime.NumCPU(),
+		"maximal simulator concurrency of a config",
+	)
+	maximalPass := flag.Int(
+		"maximalPass",
+		server.DefaultMaximalPass,
+		"maximal pass of a config",
+	)
+	maximalGameCount := flag.Int(
+		"maximalGameCount",
+		server.DefaultMaximalGameCount,
+		"maximal game count of a config",
+	)
+	writeMargin := flag.Duration(
+		"writeMargin",
+		time.Second,
+		"time for finishing the last pass and writing the last events",
+	)
+	flag.Parse()
+
+	config := configs.Config{UCBFactor: 1, MaximalDuration: time.Second}
+	if *configPath != "" {
+		data, err := ioutil.ReadFile(*configPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := json.Unmarshal(data, &config); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if err := config.Validate(); err != nil {
+		log.Fatal(err)
+	}
+
+	registry := metrics.NewRegistry("atari_montecarlo", time.Now)
+	expvar.Publish("atari_montecarlo", registry.Var())
+
+	handler := server.NewServer(server.Settings{
+		BaseConfig:         config,
+		MaximalConcurrency: *concurrency,
+		Timeout:            *timeout,
+		AnalysisTimeout:    *analysisTimeout,
+		MaximalBoardSide:   *maximalBoardSide,
+		Metrics:            registry,
+
+		MaximalBuilderConcurrency:   *maximalBuilderConcurrency,
+		MaximalSimulatorConcurrency: *maximalSimulatorConcurrency,
+		MaximalPass:                 *maximalPass,
+		MaximalGameCount:            *maximalGameCount,
+	})
+	maximalTimeout := *timeout
+	if *analysisTimeout > maximalTimeout {
+		maximalTimeout = *analysisTimeout
+	}
+
+	// the write timeout is counted from the start of reading of a request,
+	// so it covers reading of the request, the longest search (including
+	// waiting for a search slot, because both are limited by one context)
+	// and the margin; snapshots are written during the search,
+	// so their interval doesn't extend it
+	httpServer := &http.Server{
+		Addr:         *address,
+		Handler:      handler,
+		ReadTimeout:  *timeout,
+		WriteTimeout: *timeout + maximalTimeout + *writeMargin,
+	}
+
+	log.Printf("listening on %s", *address)
+	log.Fatal(httpServer.ListenAndServe())
+}
@@ -0,0 +1,137 @@
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"flag"
+	"io/ioutil"
+	"log"
+	"os"
+	"strconv"
+	"strings"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/configs"
+	"github.com/thewizardplusplus/go-atari-montecarlo/selfplay"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tuning"
+)
+
+func main() {
+	configPath := flag.String("config", "", "path to a base config in JSON")
+	parameterNames := flag.String(
+		"parameters",
+		"ucb_factor",
+		"comma-separated names of tuned parameters",
+	)
+	iterationCount := flag.Int("iterations", 100, "count of SPSA iterations")
+	learningRate := flag.Float64("learningRate", 0.01, "SPSA learning rate")
+	perturbation := flag.Float64("perturbation", 0.05, "SPSA perturbation")
+	seed := flag.Int64("seed", 1, "seed of a randomizer")
+	gameCount := flag.Int("games", 20, "count of games for an iteration")
+	concurrency := flag.Int("concurrency", 1, "count of parallel games")
+	boardWidth := flag.Int("width", 5, "board width")
+	boardHeight := flag.Int("height", 5, "board height")
+	openingMoveCount := flag.Int("openingMoves", 2, "count of random moves")
+	outputPath := flag.String(
+		"output",
+		"tuned.json",
+		"path to a tuned config (the final SPSA iterate)",
+	)
+	logPath := flag.String("log", "tuning.csv", "path to a convergence log")
+	flag.Parse()
+
+	baseConfig := configs.Config{UCBFactor: 1, MaximalPass: 100}
+	if *configPath != "" {
+		data, err := ioutil.ReadFile(*configPath)
+		if err != nil {
+			log.Fatal(err)
+		}
+		if err := json.Unmarshal(data, &baseConfig); err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	var parameters []configs.Parameter
+	for _, name := range strings.Split(*parameterNames, ",") {
+		parameter, ok := configs.FindParameter(strings.TrimSpace(name))
+		if !ok {
+			log.Fatalf("unknown parameter %q", name)
+		}
+
+		parameters = append(parameters, parameter)
+	}
+
+	logFile, err := os.Create(*logPath)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer logFile.Close() // nolint: errcheck
+
+	logWriter := csv.NewWriter(logFile)
+	header := []string{"iteration", "score"}
+	for _, parameter := range parameters {
+		header = append(header, parameter.Name)
+	}
+	logWriter.Write(header) // nolint: errcheck
+
+	objective := tuning.MatchObjective{
+		BaseConfig: baseConfig,
+		Parameters: parameters,
+		Match: selfplay.Match{
+			Game: selfplay.Game{
+				MoveGenerator: models.MoveGenerator{},
+				Size: models.Size{
+					Width:  *boardWidth,
+					Height: *boardHeight,
+				},
+				OpeningMoveCount: *openingMoveCount,
+			},
+			GameCount:   *gameCount,
+			Concurrency: *concurrency,
+			Seed:        *seed,
+		},
+	}
+	spsa := tuning.SPSA{
+		IterationCount:    *iterationCount,
+		LearningRate:      *learningRate,
+		Perturbation:      *perturbation,
+		StabilityConstant: float64(*iterationCount) / 10,
+		Seed:              *seed,
+		Logger: func(entry tuning.LogEntry) {
+			record := []string{
+				strconv.Itoa(entry.Iteration),
+				strconv.FormatFloat(entry.Score, 'f', -1, 64),
+			}
+			for _, value := range entry.Values {
+				record = append(record, strconv.FormatFloat(value, 'f', -1, 64))
+			}
+			logWriter.Write(record) // nolint: errcheck
+			logWriter.Flush()
+
+			log.Printf("iteration %d: score %.3f", entry.Iteration, entry.Score)
+		},
+	}
+	tunedParameters, err := spsa.Tune(objective.NewParameters(), objective)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := logWriter.Error(); err != nil {
+		log.Fatal(err)
+	}
+
+	// the objective only compares pairs of points, so there is no score
+	// of a single point to choose the best one by; therefore, the final
+	// iterate, which the SPSA converges to, is written as the tuned config
+	var values []float64
+	for _, parameter := range tunedParameters {
+		values = append(values, parameter.Value)
+	}
+
+	data, err := json.MarshalIndent(objective.Config(values), "", "  ")
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := ioutil.WriteFile(*outputPath, data, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,405 @@
+package configs
+
+import (
+	"context"
+	"errors"
+	"math/rand"
+	"time"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/builders"
+	"github.com/thewizardplusplus/go-atari-montecarlo/builders/terminators"
+	"github.com/thewizardplusplus/go-atari-montecarlo/filters"
+	"github.com/thewizardplusplus/go-atari-montecarlo/observers"
+	"github.com/thewizardplusplus/go-atari-montecarlo/searchers"
+	"github.com/thewizardplusplus/go-atari-montecarlo/selectors"
+	"github.com/thewizardplusplus/go-atari-montecarlo/selectors/scorers"
+	"github.com/thewizardplusplus/go-atari-montecarlo/simulators"
+	"github.com/thewizardplusplus/go-atari-montecarlo/simulators/bulky"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// ...
+var (
+	ErrInvalidConfig = errors.New("invalid config")
+)
+
+// Config ...
+//
+// It describes a composition of components of a searcher, so the latter can be
+// stored, transferred and tuned.
+//
+// Zero budgets are ignored, but at least one of them should be set. Budgets
+// are applied to each parallel builder separately.
+//
+type Config struct {
+	UCBFactor float64 `json:"ucb_factor"`
+	// If it's greater than one, simulators.ParallelSimulator is used.
+	SimulatorConcurrency int `json:"simulator_concurrency"`
+	// If it's set, bulky.AllNodesSimulator is used instead
+	// of bulky.FirstNodeSimulator.
+	AllNodesSimulation bool `json:"all_nodes_simulation"`
+	// If it's greater than one, builders.ParallelBuilder is used.
+	BuilderConcurrency int `json:"builder_concurrency"`
+	// If it's set, builders.PruningBuilder is used.
+	MaximalNodeCount int `json:"maximal_node_count"`
+	// If the width is greater than one, selectors.RootWideningSelector
+	// is used for building, e.g. for multi-PV analysis.
+	RootWideningWidth int     `json:"root_widening_width"`
+	RootWideningShare float64 `json:"root_widening_share"`
+	// If it's set, leaves at the maximal depth aren't expanded
+	// (see builders.TreeBuilder).
+	MaximalDepth int `json:"maximal_depth"`
+	// If it's set, leaves at depths less than it are expanded without
+	// symmetric moves (see builders.TreeBuilder).
+	SymmetryDepth int `json:"symmetry_depth"`
+	// If it's set, selectors.TemperatureSelector is used for selection
+	// of a found move.
+	Temperature float64 `json:"temperature"`
+	// If it's set, selectors.BlunderSelector is used for selection
+	// of a found move.
+	BlunderProbability float64 `json:"blunder_probability"`
+
+	MaximalPass      int           `json:"maximal_pass"`
+	MaximalGameCount int           `json:"maximal_game_count"`
+	MaximalDuration  time.Duration `json:"maximal_duration"`
+}
+
+// Validate ...
+func (config Config) Validate() error {
+	if config.UCBFactor < 0 ||
+		config.SimulatorConcurrency < 0 ||
+		config.BuilderConcurrency < 0 ||
+		config.MaximalNodeCount < 0 ||
+		config.RootWideningWidth < 0 ||
+		config.RootWideningShare < 0 ||
+		config.RootWideningShare > 1 ||
+		config.MaximalDepth < 0 ||
+		config.SymmetryDepth < 0 ||
+		config.Temperature < 0 ||
+		config.BlunderProbability < 0 ||
+		config.BlunderProbability > 1 ||
+		config.MaximalPass < 0 ||
+		config.MaximalGameCount < 0 ||
+		config.MaximalDuration < 0 {
+		return ErrInvalidConfig
+	}
+	if config.MaximalPass == 0 &&
+		config.MaximalGameCount == 0 &&
+		config.MaximalDuration == 0 {
+		return ErrInvalidConfig
+	}
+
+	return nil
+}
+
+// NewSearcher ...
+//
+// Returned error can be ErrInvalidConfig only.
+//
+func (config Config) NewSearcher() (searchers.MoveSearcher, error) {
+	return config.NewObservedSearcher(nil)
+}
+
+// NewObservedSearcher ...
+//
+// It's an equivalent of the method NewSearcher(), but the observer is passed
+// to all components of the searcher. If the builder concurrency is greater
+// than one, the observer should be safe for concurrent use.
+//
+// Returned error can be ErrInvalidConfig only.
+//
+func (config Config) NewObservedSearcher(
+	observer observers.Observer,
+) (searchers.MoveSearcher, error) {
+	terminatorFactory := terminators.TerminatorFactoryFunc(config.NewTerminator)
+	return config.newSearcher(
+		models.MoveGenerator{},
+		terminatorFactory,
+		observer,
+		nil,
+	)
+}
+
+// NewSeededSearcher ...
+//
+// It's an equivalent of the method NewSearcher(), but random choices
+// of the searcher are made by a randomizer with the passed seed, e.g.
+// for reproducible self-play. Rollouts are seeded too, if both concurrencies
+// don't exceed one; otherwise, the order of rollouts isn't deterministic
+// anyway, so they use the global randomizer. Searches are reproduced exactly,
+// only if their budgets don't include the maximal duration.
+//
+// The searcher isn't safe for concurrent use.
+//
+// Returned error can be ErrInvalidConfig only.
+//
+func (config Config) NewSeededSearcher(
+	seed int64,
+) (searchers.MoveSearcher, error) {
+	terminatorFactory := terminators.TerminatorFactoryFunc(config.NewTerminator)
+	return config.newSearcher(
+		models.MoveGenerator{},
+		terminatorFactory,
+		nil,
+		rand.New(rand.NewSource(seed)),
+	)
+}
+
+// NewFilteredSearcher ...
+//
+// It's an equivalent of the method NewObservedSearcher(), but moves of the tree
+// are filtered by plies from the root (see filters.FilteringGenerator),
+// so the searcher should be used for the passed root only. Moves
+// of simulations aren't filtered.
+//
+// Searches of the searcher can also return filters.ErrNoAllowedMoves,
+// if the filters leave no moves at the root
+// (see searchers.MoveSearcher.SearchMove()).
+//
+// Returned error can be ErrInvalidConfig only.
+//
+func (config Config) NewFilteredSearcher(
+	root *tree.Node,
+	moveFilters []filters.MoveFilter,
+	observer observers.Observer,
+) (searchers.MoveSearcher, error) {
+	generator := filters.NewFilteringGenerator(
+		models.MoveGenerator{},
+		root.Storage,
+		moveFilters,
+	)
+	terminatorFactory := terminators.TerminatorFactoryFunc(config.NewTerminator)
+	return config.newSearcher(generator, terminatorFactory, observer, nil)
+}
+
+// NewCancellableSearcher ...
+//
+// It's an equivalent of the method NewFilteredSearcher(), but building is also
+// terminated, when the context is done (see terminators.ContextTerminator),
+// e.g. on cancellation of a request.
+//
+// Like for the method NewFilteredSearcher(), searches of the searcher can
+// return filters.ErrNoAllowedMoves.
+//
+// Returned error can be ErrInvalidConfig only.
+//
+func (config Config) NewCancellableSearcher(
+	ctx context.Context,
+	root *tree.Node,
+	moveFilters []filters.MoveFilter,
+	observer observers.Observer,
+) (searchers.MoveSearcher, error) {
+	generator := filters.NewFilteringGenerator(
+		models.MoveGenerator{},
+		root.Storage,
+		moveFilters,
+	)
+	terminatorFactory := terminators.TerminatorFactoryFunc(
+		func(root *tree.Node) terminators.BuildingTerminator {
+			return terminators.NewGroupTerminator(
+				config.NewTerminator(root),
+				terminators.NewContextTerminator(ctx),
+			)
+		},
+	)
+	return config.newSearcher(generator, terminatorFactory, observer, nil)
+}
+
+// NewNodeSelector ...
+func (config Config) NewNodeSelector() tree.NodeSelector {
+	return selectors.MaximalNodeSelector{
+		NodeScorer: scorers.UCBScorer{Factor: config.UCBFactor},
+	}
+}
+
+// NewMoveSelector ...
+//
+// It returns a selector of a found move among children of a root.
+//
+func (config Config) NewMoveSelector() tree.NodeSelector {
+	return config.newMoveSelector(nil)
+}
+
+// NewPassBuilder ...
+//
+// It returns a builder that performs a single pass; the builder concurrency
+// and budgets are ignored.
+//
+func (config Config) NewPassBuilder() builders.Builder {
+	return config.NewObservedPassBuilder(nil)
+}
+
+// NewObservedPassBuilder ...
+//
+// It's an equivalent of the method NewPassBuilder(), but the observer is
+// passed to the builder.
+//
+func (config Config) NewObservedPassBuilder(
+	observer observers.Observer,
+) builders.Builder {
+	return config.newPassBuilder(models.MoveGenerator{}, observer, nil)
+}
+
+// NewFilteredPassBuilder ...
+//
+// It's an equivalent of the method NewObservedPassBuilder(), but moves
+// of the tree are filtered like in the method NewFilteredSearcher().
+//
+func (config Config) NewFilteredPassBuilder(
+	root *tree.Node,
+	moveFilters []filters.MoveFilter,
+	observer observers.Observer,
+) builders.Builder {
+	generator := filters.NewFilteringGenerator(
+		models.MoveGenerator{},
+		root.Storage,
+		moveFilters,
+	)
+	return config.newPassBuilder(generator, observer, nil)
+}
+
+func (config Config) newSearcher(
+	generator models.Generator,
+	terminatorFactory terminators.TerminatorFactory,
+	observer observers.Observer,
+	randomizer *rand.Rand,
+) (searchers.MoveSearcher, error) {
+	if err := config.Validate(); err != nil {
+		return searchers.MoveSearcher{}, err
+	}
+
+	// randomizers aren't safe for concurrent use
+	rolloutRandomizer := randomizer
+	if config.BuilderConcurrency > 1 || config.SimulatorConcurrency > 1 {
+		rolloutRandomizer = nil
+	}
+
+	var builder builders.Builder // nolint: staticcheck
+	builder = builders.FactoryBuilder{
+		BuilderFactory: builders.BuilderFactoryFunc(func() builders.Builder {
+			return config.newPassBuilder(generator, observer, rolloutRandomizer)
+		}),
+		TerminatorFactory: terminatorFactory,
+		Observer:          observer,
+	}
+	if config.BuilderConcurrency > 1 {
+		builder = builders.ParallelBuilder{
+			Builder:     builder,
+			Concurrency: config.BuilderConcurrency,
+			Observer:    observer,
+		}
+	}
+
+	searcher := searchers.MoveSearcher{
+		MoveGenerator: generator,
+		Builder:       builder,
+		NodeSelector:  config.newMoveSelector(randomizer),
+		Observer:      observer,
+	}
+	return searcher, nil
+}
+
+// if the randomizer is nil, the global one is used
+func (config Config) newMoveSelector(
+	randomizer *rand.Rand,
+) tree.NodeSelector {
+	var selector tree.NodeSelector // nolint: staticcheck
+	selector = config.NewNodeSelector()
+	if config.Temperature != 0 {
+		selector = selectors.TemperatureSelector{
+			Temperature: config.Temperature,
+			Randomizer:  randomizer,
+		}
+	}
+	if config.BlunderProbability != 0 {
+		selector = selectors.BlunderSelector{
+			NodeSelector: selector,
+			Probability:  config.BlunderProbability,
+			Randomizer:   randomizer,
+		}
+	}
+
+	return selector
+}
+
+// the generator is used for the tree only; simulations use the usual one;
+// if the randomizer is nil, the global one is used for rollouts
+func (config Config) newPassBuilder(
+	generator models.Generator,
+	observer observers.Observer,
+	randomizer *rand.Rand,
+) builders.Builder {
+	var simulator simulators.Simulator // nolint: staticcheck
+	simulator = simulators.RolloutSimulator{
+		MoveGenerator: models.MoveGenerator{},
+		MoveSelector:  selectors.RandomMoveSelector{Randomizer: randomizer},
+	}
+	if config.SimulatorConcurrency > 1 {
+		simulator = simulators.ParallelSimulator{
+			Simulator:   simulator,
+			Concurrency: config.SimulatorConcurrency,
+		}
+	}
+
+	var bulkySimulator builders.BulkySimulator
+	if !config.AllNodesSimulation {
+		bulkySimulator = bulky.FirstNodeSimulator{Simulator: simulator}
+	} else {
+		bulkySimulator = bulky.AllNodesSimulator{Simulator: simulator}
+	}
+
+	var selector tree.NodeSelector // nolint: staticcheck
+	selector = config.NewNodeSelector()
+	if config.RootWideningWidth > 1 {
+		selector = selectors.RootWideningSelector{
+			NodeSelector: selector,
+			Width:        config.RootWideningWidth,
+			MinimalShare: config.RootWideningShare,
+		}
+	}
+
+	var builder builders.Builder // nolint: staticcheck
+	builder = builders.TreeBuilder{
+		NodeSelector:  selector,
+		MoveGenerator: generator,
+		Simulator:     bulkySimulator,
+		MaximalDepth:  config.MaximalDepth,
+		SymmetryDepth: config.SymmetryDepth,
+		Observer:      observer,
+	}
+	if config.MaximalNodeCount != 0 {
+		builder = &builders.PruningBuilder{
+			Builder:          builder,
+			MaximalNodeCount: config.MaximalNodeCount,
+		}
+	}
+
+	return builder
+}
+
+// NewTerminator ...
+//
+// It returns a terminator that terminates building by any of budgets.
+//
+func (config Config) NewTerminator(
+	root *tree.Node,
+) terminators.BuildingTerminator {
+	var group []terminators.BuildingTerminator
+	if config.MaximalPass != 0 {
+		terminator := terminators.NewPassTerminator(config.MaximalPass)
+		group = append(group, terminator)
+	}
+	if config.MaximalGameCount != 0 {
+		terminator :=
+			terminators.NewGameCountTerminator(root, config.MaximalGameCount)
+		group = append(group, terminator)
+	}
+	if config.MaximalDuration != 0 {
+		terminator :=
+			terminators.NewTimeTerminator(time.Now, config.MaximalDuration)
+		group = append(group, terminator)
+	}
+
+	return terminators.NewGroupTerminator(group...)
+}
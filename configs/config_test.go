@@ -0,0 +1,310 @@
+package configs
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/filters"
+	"github.com/thewizardplusplus/go-atari-montecarlo/metrics"
+	"github.com/thewizardplusplus/go-atari-montecarlo/selectors"
+	"github.com/thewizardplusplus/go-atari-montecarlo/selectors/scorers"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestConfigValidate(test *testing.T) {
+	type data struct {
+		config  Config
+		wantErr error
+	}
+
+	for _, data := range []data{
+		{
+			config:  Config{UCBFactor: 1, MaximalPass: 10},
+			wantErr: nil,
+		},
+		{
+			config:  Config{UCBFactor: 1, MaximalDuration: time.Second},
+			wantErr: nil,
+		},
+		{
+			config:  Config{UCBFactor: -1, MaximalPass: 10},
+			wantErr: ErrInvalidConfig,
+		},
+		{
+			config:  Config{UCBFactor: 1, MaximalPass: 10, MaximalGameCount: -1},
+			wantErr: ErrInvalidConfig,
+		},
+		{
+			config:  Config{UCBFactor: 1, MaximalPass: 10, RootWideningShare: 2},
+			wantErr: ErrInvalidConfig,
+		},
+		{
+			config:  Config{UCBFactor: 1, MaximalPass: 10, Temperature: -1},
+			wantErr: ErrInvalidConfig,
+		},
+		{
+			config:  Config{UCBFactor: 1, MaximalPass: 10, BlunderProbability: 2},
+			wantErr: ErrInvalidConfig,
+		},
+		{
+			config:  Config{UCBFactor: 1},
+			wantErr: ErrInvalidConfig,
+		},
+	} {
+		err := data.config.Validate()
+
+		if err != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestConfigNewSearcher(test *testing.T) {
+	type data struct {
+		config   Config
+		wantMove models.Move
+		wantErr  error
+	}
+
+	for _, data := range []data{
+		{
+			config: Config{UCBFactor: 1, MaximalPass: 2},
+			wantMove: models.Move{
+				Color: models.Black,
+				Point: models.Point{Column: 4, Row: 4},
+			},
+			wantErr: nil,
+		},
+		{
+			config: Config{
+				UCBFactor:            1,
+				SimulatorConcurrency: 2,
+				AllNodesSimulation:   true,
+				BuilderConcurrency:   2,
+				MaximalNodeCount:     10,
+				RootWideningWidth:    2,
+				RootWideningShare:    0.25,
+				MaximalGameCount:     10,
+				MaximalDuration:      time.Second,
+			},
+			wantMove: models.Move{
+				Color: models.Black,
+				Point: models.Point{Column: 4, Row: 4},
+			},
+			wantErr: nil,
+		},
+	} {
+		searcher, err := data.config.NewSearcher()
+		if err != data.wantErr {
+			test.Fail()
+			continue
+		}
+
+		// +-+-+-+-+-+
+		// |W|W|W|W|X|
+		// +-+-+-+-+-+
+		// |W|W|W|W|W|
+		// +-+-+-+-+-+
+		// |W|W|W|W|W|
+		// +-+-+-+-+-+
+		// |W|W|W|W|W|
+		// +-+-+-+-+-+
+		// |W|W|W|W|W|
+		// +-+-+-+-+-+
+		board := models.NewBoard(models.Size{Width: 5, Height: 5})
+		points := board.Size().Points()
+		for _, point := range points[:len(points)-1] {
+			board = board.ApplyMove(models.Move{Color: models.White, Point: point})
+		}
+
+		preliminaryMove := models.NewPreliminaryMove(models.Black)
+		root := &tree.Node{Move: preliminaryMove, Storage: board}
+		node, err := searcher.SearchMove(root)
+
+		if !reflect.DeepEqual(node.Move, data.wantMove) {
+			test.Fail()
+		}
+		if err != nil {
+			test.Fail()
+		}
+	}
+}
+
+func TestConfigNewSearcher_withError(test *testing.T) {
+	_, err := Config{UCBFactor: 1}.NewSearcher()
+
+	if err != ErrInvalidConfig {
+		test.Fail()
+	}
+}
+
+func TestConfigNewSearcher_withSymmetryDepth(test *testing.T) {
+	config := Config{UCBFactor: 1, SymmetryDepth: 1, MaximalPass: 10}
+	searcher, err := config.NewSearcher()
+	if err != nil {
+		test.Fail()
+		return
+	}
+
+	preliminaryMove := models.NewPreliminaryMove(models.Black)
+	board := models.NewBoard(models.Size{Width: 3, Height: 3})
+	root := &tree.Node{Move: preliminaryMove, Storage: board}
+	node, err := searcher.SearchMove(root)
+
+	// the corner, the edge and the center
+	if len(root.Children) != 3 {
+		test.Fail()
+	}
+	if node.Parent != root {
+		test.Fail()
+	}
+	if err != nil {
+		test.Fail()
+	}
+}
+
+func TestConfigNewSeededSearcher(test *testing.T) {
+	config := Config{
+		UCBFactor:          1,
+		Temperature:        1,
+		BlunderProbability: 0.5,
+		MaximalPass:        20,
+	}
+
+	var moveGroups [2][]models.Move
+	for index := range moveGroups {
+		searcher, err := config.NewSeededSearcher(1)
+		if err != nil {
+			test.Fail()
+			return
+		}
+
+		for i := 0; i < 10; i++ {
+			preliminaryMove := models.NewPreliminaryMove(models.Black)
+			board := models.NewBoard(models.Size{Width: 3, Height: 3})
+			root := &tree.Node{Move: preliminaryMove, Storage: board}
+			node, err := searcher.SearchMove(root)
+			if err != nil {
+				test.Fail()
+				return
+			}
+
+			moveGroups[index] = append(moveGroups[index], node.Move)
+		}
+	}
+
+	if !reflect.DeepEqual(moveGroups[0], moveGroups[1]) {
+		test.Fail()
+	}
+}
+
+func TestConfigNewObservedSearcher(test *testing.T) {
+	config := Config{UCBFactor: 1, BuilderConcurrency: 2, MaximalPass: 3}
+	observer := metrics.NewMetrics(time.Now)
+	searcher, err := config.NewObservedSearcher(observer)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	root := &tree.Node{
+		Move:    models.NewPreliminaryMove(models.Black),
+		Storage: models.NewBoard(models.Size{Width: 3, Height: 3}),
+	}
+	if _, err := searcher.SearchMove(root); err != nil {
+		test.Fatal(err)
+	}
+
+	values := observer.Values()
+	if values.PassCount != 6 ||
+		values.ExpansionCount != 2 ||
+		values.SimulationCount != 6 ||
+		values.MergeCount != 2 ||
+		values.SearchCount != 1 ||
+		values.TreeNodeCount != int64(root.NodeCount()) {
+		test.Fail()
+	}
+}
+
+func TestConfigNewMoveSelector(test *testing.T) {
+	type data struct {
+		config Config
+		want   tree.NodeSelector
+	}
+
+	for _, data := range []data{
+		{
+			config: Config{UCBFactor: 1},
+			want: selectors.MaximalNodeSelector{
+				NodeScorer: scorers.UCBScorer{Factor: 1},
+			},
+		},
+		{
+			config: Config{UCBFactor: 1, Temperature: 0.5},
+			want:   selectors.TemperatureSelector{Temperature: 0.5},
+		},
+		{
+			config: Config{UCBFactor: 1, Temperature: 0.5, BlunderProbability: 0.1},
+			want: selectors.BlunderSelector{
+				NodeSelector: selectors.TemperatureSelector{Temperature: 0.5},
+				Probability:  0.1,
+			},
+		},
+	} {
+		got := data.config.NewMoveSelector()
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+	}
+}
+
+func TestConfigNewFilteredSearcher(test *testing.T) {
+	type data struct {
+		moveFilters []filters.MoveFilter
+		wantMove    models.Move
+		wantErr     error
+	}
+
+	for _, data := range []data{
+		{
+			moveFilters: []filters.MoveFilter{
+				{AllowedPoints: []models.Point{{Column: 2, Row: 0}}},
+			},
+			wantMove: models.Move{
+				Color: models.Black,
+				Point: models.Point{Column: 2, Row: 0},
+			},
+			wantErr: nil,
+		},
+		{
+			moveFilters: []filters.MoveFilter{
+				{AllowedPoints: []models.Point{{Column: 0, Row: 1}}},
+			},
+			wantErr: filters.ErrNoAllowedMoves,
+		},
+	} {
+		root := &tree.Node{
+			Move:    models.NewPreliminaryMove(models.Black),
+			Storage: models.NewBoard(models.Size{Width: 3, Height: 1}),
+		}
+		searcher, err := Config{UCBFactor: 1, MaximalPass: 10}.
+			NewFilteredSearcher(root, data.moveFilters, nil)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		node, err := searcher.SearchMove(root)
+
+		if err != data.wantErr {
+			test.Fail()
+		}
+		if err == nil && !reflect.DeepEqual(node.Move, data.wantMove) {
+			test.Fail()
+		}
+		if err == nil && len(root.Children) != 1 {
+			test.Fail()
+		}
+	}
+}
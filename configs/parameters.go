@@ -0,0 +1,143 @@
+package configs
+
+import (
+	"math"
+)
+
+// Parameter ...
+//
+// It describes a numeric parameter of a config that can be tuned.
+//
+type Parameter struct {
+	Name      string
+	Minimum   float64
+	Maximum   float64
+	IsInteger bool
+	Get       func(config Config) float64
+	Set       func(config *Config, value float64)
+}
+
+// Parameters ...
+//
+// It returns parameters of components that affect the strength of a searcher
+// rather than its budget:
+//
+//   - the factor of scorers.UCBScorer;
+//   - the maximal node count of builders.PruningBuilder;
+//   - the width and the share of selectors.RootWideningSelector;
+//   - the maximal and symmetry depths of builders.TreeBuilder;
+//   - the temperature of selectors.TemperatureSelector;
+//   - the probability of selectors.BlunderSelector.
+//
+// Budgets and concurrencies aren't tunable, because a self-play objective
+// just pushes them up. Zero values that disable pruning or limiting
+// of the depth are excluded from ranges, so the tuning doesn't step across
+// these discontinuities.
+//
+func Parameters() []Parameter {
+	return []Parameter{
+		{
+			Name:    "ucb_factor",
+			Minimum: 0,
+			Maximum: 4,
+			Get:     func(config Config) float64 { return config.UCBFactor },
+			Set: func(config *Config, value float64) {
+				config.UCBFactor = value
+			},
+		},
+		{
+			Name:      "maximal_node_count",
+			Minimum:   100,
+			Maximum:   1e6,
+			IsInteger: true,
+			Get: func(config Config) float64 {
+				return float64(config.MaximalNodeCount)
+			},
+			Set: func(config *Config, value float64) {
+				config.MaximalNodeCount = roundInt(value)
+			},
+		},
+		{
+			Name:      "root_widening_width",
+			Minimum:   1,
+			Maximum:   16,
+			IsInteger: true,
+			Get: func(config Config) float64 {
+				return float64(config.RootWideningWidth)
+			},
+			Set: func(config *Config, value float64) {
+				config.RootWideningWidth = roundInt(value)
+			},
+		},
+		{
+			Name:    "root_widening_share",
+			Minimum: 0,
+			Maximum: 1,
+			Get: func(config Config) float64 {
+				return config.RootWideningShare
+			},
+			Set: func(config *Config, value float64) {
+				config.RootWideningShare = value
+			},
+		},
+		{
+			Name:      "maximal_depth",
+			Minimum:   1,
+			Maximum:   64,
+			IsInteger: true,
+			Get: func(config Config) float64 {
+				return float64(config.MaximalDepth)
+			},
+			Set: func(config *Config, value float64) {
+				config.MaximalDepth = roundInt(value)
+			},
+		},
+		{
+			Name:      "symmetry_depth",
+			Minimum:   0,
+			Maximum:   16,
+			IsInteger: true,
+			Get: func(config Config) float64 {
+				return float64(config.SymmetryDepth)
+			},
+			Set: func(config *Config, value float64) {
+				config.SymmetryDepth = roundInt(value)
+			},
+		},
+		{
+			Name:    "temperature",
+			Minimum: 0,
+			Maximum: 2,
+			Get:     func(config Config) float64 { return config.Temperature },
+			Set: func(config *Config, value float64) {
+				config.Temperature = value
+			},
+		},
+		{
+			Name:    "blunder_probability",
+			Minimum: 0,
+			Maximum: 1,
+			Get: func(config Config) float64 {
+				return config.BlunderProbability
+			},
+			Set: func(config *Config, value float64) {
+				config.BlunderProbability = value
+			},
+		},
+	}
+}
+
+// FindParameter ...
+func FindParameter(name string) (Parameter, bool) {
+	for _, parameter := range Parameters() {
+		if parameter.Name == name {
+			return parameter, true
+		}
+	}
+
+	return Parameter{}, false
+}
+
+func roundInt(value float64) int {
+	return int(math.Round(value))
+}
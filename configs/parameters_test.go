@@ -0,0 +1,56 @@
+package configs
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParameters(test *testing.T) {
+	var config Config
+	for index, parameter := range Parameters() {
+		parameter.Set(&config, float64(index+1))
+	}
+
+	want := Config{
+		UCBFactor:          1,
+		MaximalNodeCount:   2,
+		RootWideningWidth:  3,
+		RootWideningShare:  4,
+		MaximalDepth:       5,
+		SymmetryDepth:      6,
+		Temperature:        7,
+		BlunderProbability: 8,
+	}
+	if !reflect.DeepEqual(config, want) {
+		test.Fail()
+	}
+
+	for index, parameter := range Parameters() {
+		if parameter.Get(config) != float64(index+1) ||
+			parameter.Minimum >= parameter.Maximum {
+			test.Fail()
+		}
+	}
+}
+
+func TestFindParameter(test *testing.T) {
+	type data struct {
+		name     string
+		wantName string
+		wantOk   bool
+	}
+
+	for _, data := range []data{
+		{name: "ucb_factor", wantName: "ucb_factor", wantOk: true},
+		{name: "unknown", wantName: "", wantOk: false},
+	} {
+		got, gotOk := FindParameter(data.name)
+
+		if got.Name != data.wantName {
+			test.Fail()
+		}
+		if gotOk != data.wantOk {
+			test.Fail()
+		}
+	}
+}
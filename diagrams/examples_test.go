@@ -0,0 +1,32 @@
+package diagrams_test
+
+import (
+	"fmt"
+	"log"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/diagrams"
+)
+
+func ExampleParse() {
+	storage, previousMove, err := diagrams.Parse(`
+		W W W
+		W W W
+		W w .
+	`)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	move := models.Move{
+		Color: previousMove.Color.Negative(),
+		Point: models.Point{Column: 2, Row: 2},
+	}
+	fmt.Print(diagrams.Render(storage.ApplyMove(move), move))
+
+	// Output:
+	// to move: W
+	// W W W
+	// W W W
+	// W W b
+}
@@ -0,0 +1,160 @@
+package diagrams
+
+import (
+	"errors"
+	"strings"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// ...
+const (
+	SideToMovePrefix = "to move:"
+)
+
+// ...
+var (
+	ErrEmptyDiagram          = errors.New("empty diagram")
+	ErrInvalidCell           = errors.New("invalid cell")
+	ErrUnevenRows            = errors.New("uneven rows")
+	ErrSeveralLastMoves      = errors.New("several last moves")
+	ErrInvalidSideToMove     = errors.New("invalid side to move")
+	ErrConflictingSideToMove = errors.New("conflicting side to move")
+)
+
+// Parse ...
+//
+// It parses a textual diagram of a position. Each non-empty line
+// of the diagram is a row of the board; cells are described by the following
+// characters (whitespaces are ignored):
+//
+//   - "B" or "W" is a black or a white stone;
+//   - "b" or "w" is a black or a white stone that was placed by the last move
+//     (it can be used once at most);
+//   - "." is an empty point.
+//
+// Also, the diagram can contain a line with the prefix SideToMovePrefix
+// and the color of the side to move ("B" or "W").
+//
+// If the last move is marked, it's returned as the previous move. Otherwise,
+// a preliminary move for the side to move is returned; by default, black
+// moves first.
+//
+// Returned error can be ErrEmptyDiagram, ErrInvalidCell, ErrUnevenRows,
+// ErrSeveralLastMoves, ErrInvalidSideToMove or ErrConflictingSideToMove only.
+//
+func Parse(diagram string) (models.StoneStorage, models.Move, error) {
+	var rows []string
+	var sideToMove *models.Color
+	for _, line := range strings.Split(diagram, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		if strings.HasPrefix(line, SideToMovePrefix) {
+			value := strings.TrimPrefix(line, SideToMovePrefix)
+			color, ok := parseColor(strings.TrimSpace(value))
+			if !ok || sideToMove != nil {
+				return nil, models.Move{}, ErrInvalidSideToMove
+			}
+
+			sideToMove = &color
+			continue
+		}
+
+		// cells are checked before widths of rows, so the byte length of a row
+		// equals its cell count
+		row := strings.Join(strings.Fields(line), "")
+		if strings.Trim(row, cellCharacters) != "" {
+			return nil, models.Move{}, ErrInvalidCell
+		}
+
+		rows = append(rows, row)
+	}
+	if len(rows) == 0 {
+		return nil, models.Move{}, ErrEmptyDiagram
+	}
+
+	size := models.Size{Width: len(rows[0]), Height: len(rows)}
+	storage := models.NewBoard(size)
+	var lastMove *models.Move
+	for rowIndex, row := range rows {
+		if len(row) != size.Width {
+			return nil, models.Move{}, ErrUnevenRows
+		}
+
+		for columnIndex, cell := range row {
+			if cell == '.' {
+				continue
+			}
+
+			color, ok := parseColor(strings.ToUpper(string(cell)))
+			if !ok {
+				return nil, models.Move{}, ErrInvalidCell
+			}
+
+			point := models.Point{Column: columnIndex, Row: rowIndex}
+			move := models.Move{Color: color, Point: point}
+			storage = storage.ApplyMove(move)
+
+			if cell == 'b' || cell == 'w' {
+				if lastMove != nil {
+					return nil, models.Move{}, ErrSeveralLastMoves
+				}
+
+				lastMove = &move
+			}
+		}
+	}
+
+	previousMove := models.NewPreliminaryMove(models.Black)
+	switch {
+	case lastMove != nil:
+		if sideToMove != nil && *sideToMove == lastMove.Color {
+			return nil, models.Move{}, ErrConflictingSideToMove
+		}
+
+		previousMove = *lastMove
+	case sideToMove != nil:
+		previousMove = models.NewPreliminaryMove(*sideToMove)
+	}
+
+	return storage, previousMove, nil
+}
+
+const cellCharacters = "BWbw."
+
+func parseColor(value string) (models.Color, bool) {
+	switch value {
+	case "B":
+		return models.Black, true
+	case "W":
+		return models.White, true
+	default:
+		return 0, false
+	}
+}
+
+// ParsePosition ...
+//
+// It's the same as the function Parse(), but it returns a position with
+// an explicit side to move; the marked last move becomes its history.
+//
+func ParsePosition(diagram string) (tree.Position, error) {
+	storage, previousMove, err := Parse(diagram)
+	if err != nil {
+		return tree.Position{}, err
+	}
+
+	position := tree.Position{
+		Storage:    storage,
+		SideToMove: previousMove.Color.Negative(),
+	}
+	if storage.Size().HasPoint(previousMove.Point) {
+		position.History = []models.Move{previousMove}
+	}
+
+	return position, nil
+}
@@ -0,0 +1,177 @@
+package diagrams
+
+import (
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestParse(test *testing.T) {
+	type data struct {
+		diagram          string
+		wantStorage      models.StoneStorage
+		wantPreviousMove models.Move
+		wantErr          error
+	}
+
+	for _, data := range []data{
+		{
+			diagram: `
+				B W .
+				. . .
+			`,
+			wantStorage: newTestBoard(
+				models.Size{Width: 3, Height: 2},
+				models.Move{Color: models.Black, Point: models.Point{}},
+				models.Move{Color: models.White, Point: models.Point{Column: 1}},
+			),
+			wantPreviousMove: models.NewPreliminaryMove(models.Black),
+			wantErr:          nil,
+		},
+		{
+			diagram: `
+				to move: W
+				B.
+				..
+			`,
+			wantStorage: newTestBoard(
+				models.Size{Width: 2, Height: 2},
+				models.Move{Color: models.Black, Point: models.Point{}},
+			),
+			wantPreviousMove: models.NewPreliminaryMove(models.White),
+			wantErr:          nil,
+		},
+		{
+			diagram: `
+				to move: B
+				B .
+				. w
+			`,
+			wantStorage: newTestBoard(
+				models.Size{Width: 2, Height: 2},
+				models.Move{Color: models.Black, Point: models.Point{}},
+				models.Move{
+					Color: models.White,
+					Point: models.Point{Column: 1, Row: 1},
+				},
+			),
+			wantPreviousMove: models.Move{
+				Color: models.White,
+				Point: models.Point{Column: 1, Row: 1},
+			},
+			wantErr: nil,
+		},
+		{
+			diagram: "\n \n",
+			wantErr: ErrEmptyDiagram,
+		},
+		{
+			diagram: "B X\n. .",
+			wantErr: ErrInvalidCell,
+		},
+		{
+			diagram: "B \u00e9\n. .",
+			wantErr: ErrInvalidCell,
+		},
+		{
+			diagram: "B .\n. \u00e9",
+			wantErr: ErrInvalidCell,
+		},
+		{
+			diagram: "B .\n.",
+			wantErr: ErrUnevenRows,
+		},
+		{
+			diagram: "b .\n. w",
+			wantErr: ErrSeveralLastMoves,
+		},
+		{
+			diagram: "to move: X\nB .",
+			wantErr: ErrInvalidSideToMove,
+		},
+		{
+			diagram: "to move: B\nto move: B\nB .",
+			wantErr: ErrInvalidSideToMove,
+		},
+		{
+			diagram: "to move: B\nb .",
+			wantErr: ErrConflictingSideToMove,
+		},
+	} {
+		storage, previousMove, err := Parse(data.diagram)
+
+		if !reflect.DeepEqual(storage, data.wantStorage) {
+			test.Fail()
+		}
+		if !reflect.DeepEqual(previousMove, data.wantPreviousMove) {
+			test.Fail()
+		}
+		if err != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func newTestBoard(
+	size models.Size,
+	moves ...models.Move,
+) models.StoneStorage {
+	board := models.NewBoard(size)
+	for _, move := range moves {
+		board = board.ApplyMove(move)
+	}
+
+	return board
+}
+
+func TestParsePosition(test *testing.T) {
+	type data struct {
+		diagram      string
+		wantPosition tree.Position
+		wantErr      error
+	}
+
+	for _, data := range []data{
+		{
+			diagram: "to move: W\nB .",
+			wantPosition: tree.Position{
+				Storage: newTestBoard(
+					models.Size{Width: 2, Height: 1},
+					models.Move{Color: models.Black, Point: models.Point{}},
+				),
+				SideToMove: models.White,
+			},
+			wantErr: nil,
+		},
+		{
+			diagram: "b .",
+			wantPosition: tree.Position{
+				Storage: newTestBoard(
+					models.Size{Width: 2, Height: 1},
+					models.Move{Color: models.Black, Point: models.Point{}},
+				),
+				SideToMove: models.White,
+				History: []models.Move{
+					{Color: models.Black, Point: models.Point{}},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			diagram:      "",
+			wantPosition: tree.Position{},
+			wantErr:      ErrEmptyDiagram,
+		},
+	} {
+		position, err := ParsePosition(data.diagram)
+
+		if !reflect.DeepEqual(position, data.wantPosition) {
+			test.Fail()
+		}
+		if err != data.wantErr {
+			test.Fail()
+		}
+	}
+}
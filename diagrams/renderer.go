@@ -0,0 +1,52 @@
+package diagrams
+
+import (
+	"strings"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+// Render ...
+//
+// It renders the position to a textual diagram that can be parsed
+// by the function Parse(). The diagram starts with the line of the side
+// to move; cells are separated by spaces. The stone of the previous move
+// is marked, if it's on the board.
+//
+func Render(storage models.StoneStorage, previousMove models.Move) string {
+	var builder strings.Builder
+	builder.WriteString(SideToMovePrefix)
+	builder.WriteString(" ")
+	builder.WriteString(renderColor(previousMove.Color.Negative()))
+	builder.WriteString("\n")
+
+	size := storage.Size()
+	for _, point := range size.Points() {
+		if point.Column != 0 {
+			builder.WriteString(" ")
+		}
+
+		cell := "."
+		if color, ok := storage.Stone(point); ok {
+			cell = renderColor(color)
+			if point == previousMove.Point && color == previousMove.Color {
+				cell = strings.ToLower(cell)
+			}
+		}
+		builder.WriteString(cell)
+
+		if point.Column == size.Width-1 {
+			builder.WriteString("\n")
+		}
+	}
+
+	return builder.String()
+}
+
+func renderColor(color models.Color) string {
+	if color == models.Black {
+		return "B"
+	}
+
+	return "W"
+}
@@ -0,0 +1,58 @@
+package diagrams
+
+import (
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+func TestRender(test *testing.T) {
+	type data struct {
+		storage      models.StoneStorage
+		previousMove models.Move
+		want         string
+	}
+
+	for _, data := range []data{
+		{
+			storage: newTestBoard(
+				models.Size{Width: 3, Height: 2},
+				models.Move{Color: models.Black, Point: models.Point{}},
+			),
+			previousMove: models.NewPreliminaryMove(models.Black),
+			want:         "to move: B\nB . .\n. . .\n",
+		},
+		{
+			storage: newTestBoard(
+				models.Size{Width: 2, Height: 2},
+				models.Move{Color: models.Black, Point: models.Point{}},
+				models.Move{Color: models.White, Point: models.Point{Row: 1}},
+			),
+			previousMove: models.Move{
+				Color: models.White,
+				Point: models.Point{Row: 1},
+			},
+			want: "to move: B\nB .\nw .\n",
+		},
+	} {
+		got := Render(data.storage, data.previousMove)
+
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
+
+func TestRender_withParse(test *testing.T) {
+	diagram := "to move: W\nB W .\n. b .\n. . W\n"
+	storage, previousMove, err := Parse(diagram)
+	if err != nil {
+		test.Fail()
+	}
+
+	got := Render(storage, previousMove)
+
+	if got != diagram {
+		test.Fail()
+	}
+}
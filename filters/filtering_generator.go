@@ -0,0 +1,78 @@
+package filters
+
+import (
+	"errors"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/boards"
+)
+
+// ...
+var (
+	ErrNoAllowedMoves = errors.New("no allowed moves")
+)
+
+// FilteringGenerator ...
+//
+// It filters moves of the inner generator by plies: the first filter is
+// applied to moves of the root, the second one to replies to them and so on;
+// further plies aren't filtered. A ply is determined by the difference
+// of stone counts of the storage and the root storage, because stones
+// are never removed during a game.
+//
+// If a filter removes all moves, ErrNoAllowedMoves is returned, so
+// tree.Node.ExpandLeaf() treats the node as a leaf and searchers.MoveSearcher
+// fails on the root. Below the root, such a leaf is simulated without
+// filters, so filters there are best-effort. Errors of the inner generator
+// are returned as is.
+//
+// It's intended for tree building and root checks; simulators should use
+// the inner generator, because tree.NewNodeState() doesn't accept
+// ErrNoAllowedMoves.
+//
+type FilteringGenerator struct {
+	Generator      models.Generator
+	RootStoneCount int
+	Filters        []MoveFilter
+}
+
+// NewFilteringGenerator ...
+//
+// It takes the root stone count from the root storage.
+//
+func NewFilteringGenerator(
+	generator models.Generator,
+	rootStorage models.StoneStorage,
+	filters []MoveFilter,
+) FilteringGenerator {
+	return FilteringGenerator{
+		Generator:      generator,
+		RootStoneCount: boards.StoneCount(rootStorage),
+		Filters:        filters,
+	}
+}
+
+// LegalMoves ...
+func (generator FilteringGenerator) LegalMoves(
+	storage models.StoneStorage,
+	previousMove models.Move,
+) ([]models.Move, error) {
+	moves, err := generator.Generator.LegalMoves(storage, previousMove)
+	if err != nil {
+		return nil, err
+	}
+
+	ply := boards.StoneCount(storage) - generator.RootStoneCount
+	if ply < 0 ||
+		ply >= len(generator.Filters) ||
+		generator.Filters[ply].IsEmpty() {
+		return moves, nil
+	}
+
+	filteredMoves := generator.Filters[ply].Filter(moves)
+	if len(filteredMoves) == 0 {
+		return nil, ErrNoAllowedMoves
+	}
+
+	return filteredMoves, nil
+}
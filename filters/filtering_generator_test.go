@@ -0,0 +1,107 @@
+package filters
+
+import (
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+func TestFilteringGeneratorLegalMoves(test *testing.T) {
+	type args struct {
+		storage      models.StoneStorage
+		previousMove models.Move
+	}
+	type data struct {
+		args      args
+		wantMoves []models.Move
+		wantErr   error
+	}
+
+	rootStorage := models.NewBoard(models.Size{Width: 3, Height: 1})
+	firstMove := models.Move{
+		Color: models.Black,
+		Point: models.Point{Column: 0, Row: 0},
+	}
+	secondMove := models.Move{
+		Color: models.White,
+		Point: models.Point{Column: 2, Row: 0},
+	}
+	for _, data := range []data{
+		// the root ply
+		{
+			args: args{
+				storage:      rootStorage,
+				previousMove: models.NewPreliminaryMove(models.Black),
+			},
+			wantMoves: []models.Move{
+				{Color: models.Black, Point: models.Point{Column: 0, Row: 0}},
+				{Color: models.Black, Point: models.Point{Column: 2, Row: 0}},
+			},
+			wantErr: nil,
+		},
+		// the ply, where the filter removes all moves
+		{
+			args: args{
+				storage:      rootStorage.ApplyMove(firstMove),
+				previousMove: firstMove,
+			},
+			wantMoves: nil,
+			wantErr:   ErrNoAllowedMoves,
+		},
+		// the ply without a filter
+		{
+			args: args{
+				storage: rootStorage.
+					ApplyMove(firstMove).
+					ApplyMove(secondMove),
+				previousMove: secondMove,
+			},
+			wantMoves: []models.Move{
+				{Color: models.Black, Point: models.Point{Column: 1, Row: 0}},
+			},
+			wantErr: nil,
+		},
+	} {
+		generator := NewFilteringGenerator(
+			models.MoveGenerator{},
+			rootStorage,
+			[]MoveFilter{
+				{DeniedPoints: []models.Point{{Column: 1, Row: 0}}},
+				{AllowedPoints: []models.Point{{Column: 0, Row: 0}}},
+			},
+		)
+		gotMoves, gotErr := generator.LegalMoves(
+			data.args.storage,
+			data.args.previousMove,
+		)
+
+		if !reflect.DeepEqual(gotMoves, data.wantMoves) {
+			test.Fail()
+		}
+		if gotErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestFilteringGeneratorLegalMoves_withGeneratorError(test *testing.T) {
+	storage := models.NewBoard(models.Size{Width: 1, Height: 1}).
+		ApplyMove(models.Move{Color: models.White})
+	generator := NewFilteringGenerator(
+		models.MoveGenerator{},
+		storage,
+		[]MoveFilter{{DeniedPoints: []models.Point{{Column: 0, Row: 0}}}},
+	)
+	gotMoves, gotErr := generator.LegalMoves(
+		storage,
+		models.Move{Color: models.White},
+	)
+
+	if gotMoves != nil {
+		test.Fail()
+	}
+	if gotErr != models.ErrAlreadyWin {
+		test.Fail()
+	}
+}
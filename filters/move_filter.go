@@ -0,0 +1,59 @@
+package filters
+
+import (
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+// MoveFilter ...
+//
+// If allowed points are set, only moves to them are kept. Moves to denied
+// points are always removed.
+//
+// Filters below the root are best-effort: if such a filter removes all moves
+// of a node, the node isn't expanded and is simulated without filters
+// (see FilteringGenerator), so the line isn't forced further. Only a filter
+// of the root is strict.
+//
+type MoveFilter struct {
+	AllowedPoints []models.Point
+	DeniedPoints  []models.Point
+}
+
+// IsEmpty ...
+//
+// It checks that the filter keeps all moves.
+//
+func (filter MoveFilter) IsEmpty() bool {
+	return len(filter.AllowedPoints) == 0 && len(filter.DeniedPoints) == 0
+}
+
+// Filter ...
+//
+// It returns a new slice, the passed one isn't changed.
+//
+func (filter MoveFilter) Filter(moves []models.Move) []models.Move {
+	filteredMoves := make([]models.Move, 0, len(moves))
+	for _, move := range moves {
+		if len(filter.AllowedPoints) != 0 &&
+			!hasPoint(filter.AllowedPoints, move.Point) {
+			continue
+		}
+		if hasPoint(filter.DeniedPoints, move.Point) {
+			continue
+		}
+
+		filteredMoves = append(filteredMoves, move)
+	}
+
+	return filteredMoves
+}
+
+func hasPoint(points []models.Point, point models.Point) bool {
+	for _, anotherPoint := range points {
+		if anotherPoint == point {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,75 @@
+package filters
+
+import (
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+func TestMoveFilterFilter(test *testing.T) {
+	type fields struct {
+		allowedPoints []models.Point
+		deniedPoints  []models.Point
+	}
+	type data struct {
+		fields    fields
+		wantMoves []models.Move
+		wantEmpty bool
+	}
+
+	moves := []models.Move{
+		{Color: models.Black, Point: models.Point{Column: 0, Row: 0}},
+		{Color: models.Black, Point: models.Point{Column: 1, Row: 0}},
+		{Color: models.Black, Point: models.Point{Column: 2, Row: 0}},
+	}
+	for _, data := range []data{
+		{
+			fields:    fields{},
+			wantMoves: moves,
+			wantEmpty: true,
+		},
+		{
+			fields: fields{
+				allowedPoints: []models.Point{{Column: 2, Row: 0}, {Column: 0, Row: 0}},
+			},
+			wantMoves: []models.Move{moves[0], moves[2]},
+			wantEmpty: false,
+		},
+		{
+			fields: fields{
+				deniedPoints: []models.Point{{Column: 1, Row: 0}},
+			},
+			wantMoves: []models.Move{moves[0], moves[2]},
+			wantEmpty: false,
+		},
+		{
+			fields: fields{
+				allowedPoints: []models.Point{{Column: 0, Row: 0}, {Column: 1, Row: 0}},
+				deniedPoints:  []models.Point{{Column: 0, Row: 0}},
+			},
+			wantMoves: []models.Move{moves[1]},
+			wantEmpty: false,
+		},
+		{
+			fields: fields{
+				allowedPoints: []models.Point{{Column: 0, Row: 1}},
+			},
+			wantMoves: []models.Move{},
+			wantEmpty: false,
+		},
+	} {
+		filter := MoveFilter{
+			AllowedPoints: data.fields.allowedPoints,
+			DeniedPoints:  data.fields.deniedPoints,
+		}
+		gotMoves := filter.Filter(moves)
+
+		if !reflect.DeepEqual(gotMoves, data.wantMoves) {
+			test.Fail()
+		}
+		if filter.IsEmpty() != data.wantEmpty {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,28 @@
+package intervals
+
+import (
+	"math"
+)
+
+// Wilson ...
+//
+// It returns bounds of the Wilson score interval of the win rate; z is
+// the quantile of the standard normal distribution, e.g. 1.96 for 95%.
+// Without games, it returns the whole range [0, 1].
+//
+func Wilson(winCount int, gameCount int, z float64) (
+	lower float64,
+	upper float64,
+) {
+	if gameCount == 0 {
+		return 0, 1
+	}
+
+	games := float64(gameCount)
+	winRate := float64(winCount) / games
+	denominator := 1 + z*z/games
+	center := (winRate + z*z/(2*games)) / denominator
+	shift := z / denominator *
+		math.Sqrt(winRate*(1-winRate)/games+z*z/(4*games*games))
+	return math.Max(center-shift, 0), math.Min(center+shift, 1)
+}
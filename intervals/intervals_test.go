@@ -0,0 +1,47 @@
+package intervals
+
+import (
+	"math"
+	"testing"
+)
+
+func TestWilson(test *testing.T) {
+	type args struct {
+		winCount  int
+		gameCount int
+		z         float64
+	}
+	type data struct {
+		args      args
+		wantLower float64
+		wantUpper float64
+	}
+
+	for _, data := range []data{
+		{
+			args:      args{winCount: 0, gameCount: 0, z: 1.96},
+			wantLower: 0,
+			wantUpper: 1,
+		},
+		{
+			args:      args{winCount: 50, gameCount: 100, z: 1.96},
+			wantLower: 0.4038,
+			wantUpper: 0.5962,
+		},
+		{
+			args:      args{winCount: 10, gameCount: 10, z: 1.96},
+			wantLower: 0.7225,
+			wantUpper: 1,
+		},
+	} {
+		gotLower, gotUpper :=
+			Wilson(data.args.winCount, data.args.gameCount, data.args.z)
+
+		if math.Abs(gotLower-data.wantLower) > 1e-4 {
+			test.Fail()
+		}
+		if math.Abs(gotUpper-data.wantUpper) > 1e-4 {
+			test.Fail()
+		}
+	}
+}
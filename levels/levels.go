@@ -0,0 +1,100 @@
+package levels
+
+import (
+	"github.com/thewizardplusplus/go-atari-montecarlo/configs"
+)
+
+// Level ...
+//
+// It's a named difficulty level of play. Its config combines a playout
+// budget, temperature-based selection of a found move, a blunder probability
+// and a maximal depth of a tree.
+//
+type Level struct {
+	Name   string
+	Config configs.Config
+}
+
+// Levels ...
+//
+// It returns levels sorted by increasing strength. Each level has a greater
+// playout budget and a greater depth and doesn't select moves more randomly
+// than the previous one.
+//
+// Levels are calibrated against each other by the long test
+// TestLevels_withCalibration (run with the build tag "long"): for each pair
+// of adjacent levels, it plays a seeded self-play match on a 5x5 board
+// with two random opening moves (see selfplay.Match.PlaySeeded()) until
+// the SPRT with H0: Elo 0 and H1: Elo 100 (alpha and beta are 0.05) makes
+// a decision. The stronger level should be accepted as stronger by H1.
+//
+// The measured win rate of each level against the previous one is noted
+// next to the level with its 95% Wilson interval and the game count. Matches
+// are seeded with 1 and results are applied in the order of games, so they
+// are reproduced exactly.
+//
+func Levels() []Level {
+	return []Level{
+		{
+			Name: "novice",
+			Config: configs.Config{
+				UCBFactor:          1,
+				MaximalDepth:       1,
+				Temperature:        2,
+				BlunderProbability: 0.3,
+				MaximalGameCount:   20,
+			},
+		},
+		// against novice: win rate 0.60 (0.53-0.67), 176 games
+		{
+			Name: "beginner",
+			Config: configs.Config{
+				UCBFactor:          1,
+				MaximalDepth:       2,
+				Temperature:        1,
+				BlunderProbability: 0.15,
+				MaximalGameCount:   50,
+			},
+		},
+		// against beginner: win rate 0.93 (0.70-0.99), 15 games
+		{
+			Name: "intermediate",
+			Config: configs.Config{
+				UCBFactor:          1,
+				MaximalDepth:       4,
+				Temperature:        0.5,
+				BlunderProbability: 0.05,
+				MaximalGameCount:   200,
+			},
+		},
+		// against intermediate: win rate 0.79 (0.60-0.91), 24 games
+		{
+			Name: "advanced",
+			Config: configs.Config{
+				UCBFactor:        1,
+				MaximalDepth:     6,
+				Temperature:      0.5,
+				MaximalGameCount: 500,
+			},
+		},
+		// against advanced: win rate 0.79 (0.60-0.91), 24 games
+		{
+			Name: "expert",
+			Config: configs.Config{
+				UCBFactor:        1,
+				MaximalGameCount: 5000,
+			},
+		},
+	}
+}
+
+// FindLevel ...
+func FindLevel(name string) (Level, bool) {
+	for _, level := range Levels() {
+		if level.Name == name {
+			return level, true
+		}
+	}
+
+	return Level{}, false
+}
@@ -0,0 +1,65 @@
+// +build long
+
+package levels_test
+
+import (
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/configs"
+	"github.com/thewizardplusplus/go-atari-montecarlo/levels"
+	"github.com/thewizardplusplus/go-atari-montecarlo/selfplay"
+)
+
+func TestLevels_withCalibration(test *testing.T) {
+	allLevels := levels.Levels()
+	for index := 1; index < len(allLevels); index++ {
+		weakerLevel, strongerLevel := allLevels[index-1], allLevels[index]
+		match := selfplay.Match{
+			Game: selfplay.Game{
+				MoveGenerator:    models.MoveGenerator{},
+				Size:             models.Size{Width: 5, Height: 5},
+				OpeningMoveCount: 2,
+			},
+			GameCount:   1000,
+			Concurrency: 4,
+			Seed:        1,
+			SPRT:        &selfplay.SPRT{Elo0: 0, Elo1: 100, Alpha: 0.05, Beta: 0.05},
+		}
+		result, err := match.PlaySeeded(
+			newSearcherFactory(strongerLevel.Config),
+			newSearcherFactory(weakerLevel.Config),
+		)
+		if err != nil {
+			test.Fatal(err)
+		}
+
+		lower, upper := result.ConfidenceInterval(1.96)
+		test.Logf(
+			"%s against %s: win rate %.2f (%.2f-%.2f), %d games",
+			strongerLevel.Name,
+			weakerLevel.Name,
+			result.WinRate(),
+			lower,
+			upper,
+			result.GameCount(),
+		)
+
+		if result.Decision != selfplay.H1Accepted {
+			test.Errorf(
+				"%s isn't proven to beat %s",
+				strongerLevel.Name,
+				weakerLevel.Name,
+			)
+		}
+	}
+}
+
+func newSearcherFactory(config configs.Config) selfplay.SearcherFactory {
+	return selfplay.SearcherFactoryFunc(func(seed int64) (
+		selfplay.Searcher,
+		error,
+	) {
+		return config.NewSeededSearcher(seed)
+	})
+}
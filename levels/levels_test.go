@@ -0,0 +1,53 @@
+package levels
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestLevels(test *testing.T) {
+	for index, level := range Levels() {
+		if err := level.Config.Validate(); err != nil {
+			test.Fail()
+		}
+
+		if index == 0 {
+			continue
+		}
+
+		previousLevel := Levels()[index-1]
+		if level.Config.MaximalGameCount <= previousLevel.Config.MaximalGameCount {
+			test.Fail()
+		}
+	}
+}
+
+func TestFindLevel(test *testing.T) {
+	type data struct {
+		name      string
+		wantLevel Level
+		wantOk    bool
+	}
+
+	for _, data := range []data{
+		{
+			name:      "beginner",
+			wantLevel: Levels()[1],
+			wantOk:    true,
+		},
+		{
+			name:      "unknown",
+			wantLevel: Level{},
+			wantOk:    false,
+		},
+	} {
+		gotLevel, gotOk := FindLevel(data.name)
+
+		if !reflect.DeepEqual(gotLevel, data.wantLevel) {
+			test.Fail()
+		}
+		if gotOk != data.wantOk {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package metrics
+
+import (
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/thewizardplusplus/go-atari-montecarlo/builders/terminators"
+	"github.com/thewizardplusplus/go-atari-montecarlo/observers"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// Values ...
+//
+// The tree node count is measured after the last search.
+//
+type Values struct {
+	PassCount       int64         `json:"pass_count"`
+	ExpansionCount  int64         `json:"expansion_count"`
+	SimulationCount int64         `json:"simulation_count"`
+	MergeCount      int64         `json:"merge_count"`
+	SearchCount     int64         `json:"search_count"`
+	SearchDuration  time.Duration `json:"search_duration"`
+	TreeNodeCount   int64         `json:"tree_node_count"`
+}
+
+// Metrics ...
+//
+// It's an observer that counts events of building and searching. Simulations
+// are counted by games, so a simulation of several games (e.g. by
+// simulators.ParallelSimulator) is counted as several ones.
+//
+// It's safe for concurrent use, so it can be used inside
+// builders.ParallelBuilder and by several searchers at once.
+//
+type Metrics struct {
+	observers.NopObserver
+
+	// these fields are accessed atomically,
+	// so they should be first for the alignment
+	passCount       int64
+	expansionCount  int64
+	simulationCount int64
+	mergeCount      int64
+	searchCount     int64
+	searchDuration  int64
+	treeNodeCount   int64
+
+	clock         terminators.Clock
+	searchesMutex sync.Mutex
+	searchStarts  map[*tree.Node]time.Time
+}
+
+// NewMetrics ...
+func NewMetrics(clock terminators.Clock) *Metrics {
+	return &Metrics{
+		clock:        clock,
+		searchStarts: make(map[*tree.Node]time.Time),
+	}
+}
+
+// Values ...
+func (metrics *Metrics) Values() Values {
+	return Values{
+		PassCount:       atomic.LoadInt64(&metrics.passCount),
+		ExpansionCount:  atomic.LoadInt64(&metrics.expansionCount),
+		SimulationCount: atomic.LoadInt64(&metrics.simulationCount),
+		MergeCount:      atomic.LoadInt64(&metrics.mergeCount),
+		SearchCount:     atomic.LoadInt64(&metrics.searchCount),
+		SearchDuration: time.Duration(
+			atomic.LoadInt64(&metrics.searchDuration),
+		),
+		TreeNodeCount: atomic.LoadInt64(&metrics.treeNodeCount),
+	}
+}
+
+// OnSearchStart ...
+func (metrics *Metrics) OnSearchStart(root *tree.Node) {
+	metrics.searchesMutex.Lock()
+	defer metrics.searchesMutex.Unlock()
+
+	metrics.searchStarts[root] = metrics.clock()
+}
+
+// OnSearchEnd ...
+func (metrics *Metrics) OnSearchEnd(root *tree.Node) {
+	metrics.searchesMutex.Lock()
+	startTime, ok := metrics.searchStarts[root]
+	delete(metrics.searchStarts, root)
+	metrics.searchesMutex.Unlock()
+
+	atomic.AddInt64(&metrics.searchCount, 1)
+	if ok {
+		duration := metrics.clock().Sub(startTime)
+		atomic.AddInt64(&metrics.searchDuration, int64(duration))
+	}
+
+	atomic.StoreInt64(&metrics.treeNodeCount, int64(root.NodeCount()))
+}
+
+// OnPassEnd ...
+func (metrics *Metrics) OnPassEnd(root *tree.Node, pass int) {
+	atomic.AddInt64(&metrics.passCount, 1)
+}
+
+// OnLeafExpansion ...
+func (metrics *Metrics) OnLeafExpansion(
+	leaf *tree.Node,
+	children tree.NodeGroup,
+) {
+	atomic.AddInt64(&metrics.expansionCount, 1)
+}
+
+// OnSimulation ...
+func (metrics *Metrics) OnSimulation(
+	node *tree.Node,
+	state tree.NodeState,
+) {
+	atomic.AddInt64(&metrics.simulationCount, int64(state.GameCount))
+}
+
+// OnMerge ...
+func (metrics *Metrics) OnMerge(root *tree.Node, rootCopy *tree.Node) {
+	atomic.AddInt64(&metrics.mergeCount, 1)
+}
@@ -0,0 +1,59 @@
+package metrics
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// the clock advances by a second on each call
+func newClock() func() time.Time {
+	var clockTime time.Time
+	return func() time.Time {
+		clockTime = clockTime.Add(time.Second)
+		return clockTime
+	}
+}
+
+func TestMetrics(test *testing.T) {
+	metrics := NewMetrics(newClock())
+
+	root := &tree.Node{}
+	child := &tree.Node{Parent: root}
+	root.Children = tree.NodeGroup{child}
+
+	metrics.OnSearchStart(root)
+	metrics.OnPassStart(root, 0)
+	metrics.OnLeafSelection(root)
+	metrics.OnSimulation(root, tree.NodeState{GameCount: 2, WinCount: 1})
+	metrics.OnPassEnd(root, 0)
+	metrics.OnPassStart(root, 1)
+	metrics.OnLeafSelection(root)
+	metrics.OnLeafExpansion(root, root.Children)
+	metrics.OnSimulation(child, tree.NodeState{GameCount: 1, WinCount: 1})
+	metrics.OnPassEnd(root, 1)
+	metrics.OnMerge(root, root)
+	metrics.OnSearchEnd(root)
+	metrics.OnMoveSelection(root, child)
+
+	// a search without a start isn't timed
+	metrics.OnSearchEnd(child)
+
+	want := Values{
+		PassCount:       2,
+		ExpansionCount:  1,
+		SimulationCount: 3,
+		MergeCount:      1,
+		SearchCount:     2,
+		SearchDuration:  time.Second,
+		TreeNodeCount:   1,
+	}
+	if got := metrics.Values(); !reflect.DeepEqual(got, want) {
+		test.Fail()
+	}
+	if len(metrics.searchStarts) != 0 {
+		test.Fail()
+	}
+}
@@ -0,0 +1,121 @@
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+)
+
+type descriptor struct {
+	name      string
+	kind      string
+	help      string
+	formatter func(values Values) string
+}
+
+var (
+	descriptors = []descriptor{
+		{
+			name: "passes_total",
+			kind: "counter",
+			help: "Count of passes of building.",
+			formatter: func(values Values) string {
+				return strconv.FormatInt(values.PassCount, 10)
+			},
+		},
+		{
+			name: "expansions_total",
+			kind: "counter",
+			help: "Count of expansions of leaves.",
+			formatter: func(values Values) string {
+				return strconv.FormatInt(values.ExpansionCount, 10)
+			},
+		},
+		{
+			name: "simulations_total",
+			kind: "counter",
+			help: "Count of simulated games.",
+			formatter: func(values Values) string {
+				return strconv.FormatInt(values.SimulationCount, 10)
+			},
+		},
+		{
+			name: "merges_total",
+			kind: "counter",
+			help: "Count of merges of root copies by parallel builders.",
+			formatter: func(values Values) string {
+				return strconv.FormatInt(values.MergeCount, 10)
+			},
+		},
+		{
+			name: "searches_total",
+			kind: "counter",
+			help: "Count of searches of moves.",
+			formatter: func(values Values) string {
+				return strconv.FormatInt(values.SearchCount, 10)
+			},
+		},
+		{
+			name: "search_duration_seconds_total",
+			kind: "counter",
+			help: "Total duration of searches of moves.",
+			formatter: func(values Values) string {
+				seconds := values.SearchDuration.Seconds()
+				return strconv.FormatFloat(seconds, 'g', -1, 64)
+			},
+		},
+		{
+			name: "tree_nodes",
+			kind: "gauge",
+			help: "Node count of the tree after the last search.",
+			formatter: func(values Values) string {
+				return strconv.FormatInt(values.TreeNodeCount, 10)
+			},
+		},
+	}
+)
+
+// WritePrometheus ...
+//
+// It writes all metrics in the Prometheus text format.
+//
+func (registry *Registry) WritePrometheus(writer io.Writer) error {
+	entries := registry.sortedEntries()
+	values := make([]Values, 0, len(entries))
+	for _, entry := range entries {
+		values = append(values, entry.metrics.Values())
+	}
+
+	bufferedWriter := bufio.NewWriter(writer)
+	for _, descriptor := range descriptors {
+		name := registry.namespace + "_" + descriptor.name
+		fmt.Fprintf(bufferedWriter, "# HELP %s %s\n", name, descriptor.help)
+		fmt.Fprintf(bufferedWriter, "# TYPE %s %s\n", name, descriptor.kind)
+
+		for index, entry := range entries {
+			fmt.Fprintf(
+				bufferedWriter,
+				"%s%s %s\n",
+				name,
+				formatLabels(entry.labels),
+				descriptor.formatter(values[index]),
+			)
+		}
+	}
+
+	return bufferedWriter.Flush()
+}
+
+// ServeHTTP ...
+//
+// It serves all metrics in the Prometheus text format.
+//
+func (registry *Registry) ServeHTTP(
+	writer http.ResponseWriter,
+	request *http.Request,
+) {
+	writer.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	registry.WritePrometheus(writer) // nolint: errcheck
+}
@@ -0,0 +1,81 @@
+package metrics
+
+import (
+	"bytes"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestRegistryWritePrometheus(test *testing.T) {
+	registry := NewRegistry("test", newClock())
+	metrics := registry.Metrics(map[string]string{"b": "2", "a": "1"})
+	root := &tree.Node{}
+	metrics.OnSearchStart(root)
+	metrics.OnSimulation(root, tree.NodeState{GameCount: 3})
+	metrics.OnSearchEnd(root)
+	registry.Metrics(nil).OnPassEnd(&tree.Node{}, 0)
+
+	var buffer bytes.Buffer
+	if err := registry.WritePrometheus(&buffer); err != nil {
+		test.Fatal(err)
+	}
+
+	want := strings.Join([]string{
+		"# HELP test_passes_total Count of passes of building.",
+		"# TYPE test_passes_total counter",
+		"test_passes_total 1",
+		`test_passes_total{a="1",b="2"} 0`,
+		"# HELP test_expansions_total Count of expansions of leaves.",
+		"# TYPE test_expansions_total counter",
+		"test_expansions_total 0",
+		`test_expansions_total{a="1",b="2"} 0`,
+		"# HELP test_simulations_total Count of simulated games.",
+		"# TYPE test_simulations_total counter",
+		"test_simulations_total 0",
+		`test_simulations_total{a="1",b="2"} 3`,
+		"# HELP test_merges_total " +
+			"Count of merges of root copies by parallel builders.",
+		"# TYPE test_merges_total counter",
+		"test_merges_total 0",
+		`test_merges_total{a="1",b="2"} 0`,
+		"# HELP test_searches_total Count of searches of moves.",
+		"# TYPE test_searches_total counter",
+		"test_searches_total 0",
+		`test_searches_total{a="1",b="2"} 1`,
+		"# HELP test_search_duration_seconds_total " +
+			"Total duration of searches of moves.",
+		"# TYPE test_search_duration_seconds_total counter",
+		"test_search_duration_seconds_total 0",
+		`test_search_duration_seconds_total{a="1",b="2"} 1`,
+		"# HELP test_tree_nodes Node count of the tree after the last search.",
+		"# TYPE test_tree_nodes gauge",
+		"test_tree_nodes 0",
+		`test_tree_nodes{a="1",b="2"} 1`,
+	}, "\n") + "\n"
+	if buffer.String() != want {
+		test.Fail()
+	}
+}
+
+func TestRegistryServeHTTP(test *testing.T) {
+	registry := NewRegistry("test", newClock())
+	registry.Metrics(nil)
+
+	request := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	recorder := httptest.NewRecorder()
+	registry.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusOK {
+		test.Fail()
+	}
+	if !strings.HasPrefix(recorder.Header().Get("Content-Type"), "text/plain") {
+		test.Fail()
+	}
+	if !strings.Contains(recorder.Body.String(), "test_passes_total 0\n") {
+		test.Fail()
+	}
+}
@@ -0,0 +1,141 @@
+package metrics
+
+import (
+	"expvar"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/thewizardplusplus/go-atari-montecarlo/builders/terminators"
+)
+
+// Registry ...
+//
+// It stores metrics by their labels, e.g. by a name of the config used
+// for a search. Metrics are never removed, so values of labels should be
+// bounded; they shouldn't be taken from clients as is.
+//
+// It's safe for concurrent use.
+//
+type Registry struct {
+	namespace string
+	clock     terminators.Clock
+
+	mutex   sync.RWMutex
+	entries map[string]entry
+}
+
+type entry struct {
+	labels  map[string]string
+	metrics *Metrics
+}
+
+// NewRegistry ...
+//
+// The namespace is used as a prefix of metric names in the Prometheus format.
+//
+func NewRegistry(namespace string, clock terminators.Clock) *Registry {
+	return &Registry{
+		namespace: namespace,
+		clock:     clock,
+		entries:   make(map[string]entry),
+	}
+}
+
+// Metrics ...
+//
+// It returns metrics with the passed labels; they are created on the first
+// request.
+//
+func (registry *Registry) Metrics(labels map[string]string) *Metrics {
+	key := formatLabels(labels)
+
+	registry.mutex.RLock()
+	entry, ok := registry.entries[key]
+	registry.mutex.RUnlock()
+	if ok {
+		return entry.metrics
+	}
+
+	registry.mutex.Lock()
+	defer registry.mutex.Unlock()
+
+	// metrics could be created while the lock was released
+	if entry, ok := registry.entries[key]; ok {
+		return entry.metrics
+	}
+
+	entry.labels = copyLabels(labels)
+	entry.metrics = NewMetrics(registry.clock)
+	registry.entries[key] = entry
+
+	return entry.metrics
+}
+
+// Var ...
+//
+// It returns a variable for the expvar package. The variable is a map
+// from formatted labels to values of metrics.
+//
+func (registry *Registry) Var() expvar.Var {
+	return expvar.Func(func() interface{} {
+		values := make(map[string]Values)
+		for _, entry := range registry.sortedEntries() {
+			values[formatLabels(entry.labels)] = entry.metrics.Values()
+		}
+
+		return values
+	})
+}
+
+func (registry *Registry) sortedEntries() []entry {
+	registry.mutex.RLock()
+	defer registry.mutex.RUnlock()
+
+	keys := make([]string, 0, len(registry.entries))
+	for key := range registry.entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	entries := make([]entry, 0, len(keys))
+	for _, key := range keys {
+		entries = append(entries, registry.entries[key])
+	}
+
+	return entries
+}
+
+// it formats labels in the Prometheus format with sorted names
+func formatLabels(labels map[string]string) string {
+	if len(labels) == 0 {
+		return ""
+	}
+
+	names := make([]string, 0, len(labels))
+	for name := range labels {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	pairs := make([]string, 0, len(names))
+	for _, name := range names {
+		pairs = append(pairs, name+`="`+escapeLabelValue(labels[name])+`"`)
+	}
+
+	return "{" + strings.Join(pairs, ",") + "}"
+}
+
+func escapeLabelValue(value string) string {
+	replacer := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+	return replacer.Replace(value)
+}
+
+func copyLabels(labels map[string]string) map[string]string {
+	labelsCopy := make(map[string]string, len(labels))
+	for name, value := range labels {
+		labelsCopy[name] = value
+	}
+
+	return labelsCopy
+}
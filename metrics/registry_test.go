@@ -0,0 +1,45 @@
+package metrics
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestRegistryMetrics(test *testing.T) {
+	registry := NewRegistry("test", newClock())
+	labels := map[string]string{"one": "1", "two": "2"}
+	metrics := registry.Metrics(labels)
+	labels["one"] = "3"
+
+	if registry.Metrics(map[string]string{"two": "2", "one": "1"}) != metrics {
+		test.Fail()
+	}
+	if registry.Metrics(labels) == metrics {
+		test.Fail()
+	}
+	if registry.Metrics(nil) == metrics {
+		test.Fail()
+	}
+}
+
+func TestRegistryVar(test *testing.T) {
+	registry := NewRegistry("test", newClock())
+	registry.Metrics(map[string]string{"one": "1"}).OnPassEnd(&tree.Node{}, 0)
+	registry.Metrics(map[string]string{"one": `"2"`})
+
+	var got map[string]Values
+	if err := json.Unmarshal([]byte(registry.Var().String()), &got); err != nil {
+		test.Fatal(err)
+	}
+
+	want := map[string]Values{
+		`{one="1"}`:     {PassCount: 1},
+		`{one="\"2\""}`: {},
+	}
+	if !reflect.DeepEqual(got, want) {
+		test.Fail()
+	}
+}
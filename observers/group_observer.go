@@ -0,0 +1,90 @@
+package observers
+
+import (
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// GroupObserver ...
+//
+// It notifies all its observers in the passed order.
+//
+type GroupObserver struct {
+	observers []Observer
+}
+
+// NewGroupObserver ...
+func NewGroupObserver(observers ...Observer) GroupObserver {
+	return GroupObserver{observers}
+}
+
+// OnSearchStart ...
+func (group GroupObserver) OnSearchStart(root *tree.Node) {
+	for _, observer := range group.observers {
+		observer.OnSearchStart(root)
+	}
+}
+
+// OnSearchEnd ...
+func (group GroupObserver) OnSearchEnd(root *tree.Node) {
+	for _, observer := range group.observers {
+		observer.OnSearchEnd(root)
+	}
+}
+
+// OnPassStart ...
+func (group GroupObserver) OnPassStart(root *tree.Node, pass int) {
+	for _, observer := range group.observers {
+		observer.OnPassStart(root, pass)
+	}
+}
+
+// OnPassEnd ...
+func (group GroupObserver) OnPassEnd(root *tree.Node, pass int) {
+	for _, observer := range group.observers {
+		observer.OnPassEnd(root, pass)
+	}
+}
+
+// OnLeafSelection ...
+func (group GroupObserver) OnLeafSelection(leaf *tree.Node) {
+	for _, observer := range group.observers {
+		observer.OnLeafSelection(leaf)
+	}
+}
+
+// OnLeafExpansion ...
+func (group GroupObserver) OnLeafExpansion(
+	leaf *tree.Node,
+	children tree.NodeGroup,
+) {
+	for _, observer := range group.observers {
+		observer.OnLeafExpansion(leaf, children)
+	}
+}
+
+// OnSimulation ...
+func (group GroupObserver) OnSimulation(
+	node *tree.Node,
+	state tree.NodeState,
+) {
+	for _, observer := range group.observers {
+		observer.OnSimulation(node, state)
+	}
+}
+
+// OnMerge ...
+func (group GroupObserver) OnMerge(root *tree.Node, rootCopy *tree.Node) {
+	for _, observer := range group.observers {
+		observer.OnMerge(root, rootCopy)
+	}
+}
+
+// OnMoveSelection ...
+func (group GroupObserver) OnMoveSelection(
+	root *tree.Node,
+	node *tree.Node,
+) {
+	for _, observer := range group.observers {
+		observer.OnMoveSelection(root, node)
+	}
+}
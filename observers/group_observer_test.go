@@ -0,0 +1,181 @@
+package observers
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+type MockObserver struct {
+	onSearchStart   func(root *tree.Node)
+	onSearchEnd     func(root *tree.Node)
+	onPassStart     func(root *tree.Node, pass int)
+	onPassEnd       func(root *tree.Node, pass int)
+	onLeafSelection func(leaf *tree.Node)
+	onLeafExpansion func(leaf *tree.Node, children tree.NodeGroup)
+	onSimulation    func(node *tree.Node, state tree.NodeState)
+	onMerge         func(root *tree.Node, rootCopy *tree.Node)
+	onMoveSelection func(root *tree.Node, node *tree.Node)
+}
+
+func (observer MockObserver) OnSearchStart(root *tree.Node) {
+	if observer.onSearchStart == nil {
+		panic("not implemented")
+	}
+
+	observer.onSearchStart(root)
+}
+
+func (observer MockObserver) OnSearchEnd(root *tree.Node) {
+	if observer.onSearchEnd == nil {
+		panic("not implemented")
+	}
+
+	observer.onSearchEnd(root)
+}
+
+func (observer MockObserver) OnPassStart(root *tree.Node, pass int) {
+	if observer.onPassStart == nil {
+		panic("not implemented")
+	}
+
+	observer.onPassStart(root, pass)
+}
+
+func (observer MockObserver) OnPassEnd(root *tree.Node, pass int) {
+	if observer.onPassEnd == nil {
+		panic("not implemented")
+	}
+
+	observer.onPassEnd(root, pass)
+}
+
+func (observer MockObserver) OnLeafSelection(leaf *tree.Node) {
+	if observer.onLeafSelection == nil {
+		panic("not implemented")
+	}
+
+	observer.onLeafSelection(leaf)
+}
+
+func (observer MockObserver) OnLeafExpansion(
+	leaf *tree.Node,
+	children tree.NodeGroup,
+) {
+	if observer.onLeafExpansion == nil {
+		panic("not implemented")
+	}
+
+	observer.onLeafExpansion(leaf, children)
+}
+
+func (observer MockObserver) OnSimulation(
+	node *tree.Node,
+	state tree.NodeState,
+) {
+	if observer.onSimulation == nil {
+		panic("not implemented")
+	}
+
+	observer.onSimulation(node, state)
+}
+
+func (observer MockObserver) OnMerge(root *tree.Node, rootCopy *tree.Node) {
+	if observer.onMerge == nil {
+		panic("not implemented")
+	}
+
+	observer.onMerge(root, rootCopy)
+}
+
+func (observer MockObserver) OnMoveSelection(
+	root *tree.Node,
+	node *tree.Node,
+) {
+	if observer.onMoveSelection == nil {
+		panic("not implemented")
+	}
+
+	observer.onMoveSelection(root, node)
+}
+
+func newRecordingObserver(name string, events *[]string) MockObserver {
+	record := func(event string) {
+		*events = append(*events, name+": "+event)
+	}
+
+	return MockObserver{
+		onSearchStart: func(root *tree.Node) {
+			record("search start")
+		},
+		onSearchEnd: func(root *tree.Node) {
+			record("search end")
+		},
+		onPassStart: func(root *tree.Node, pass int) {
+			record(fmt.Sprintf("pass start %d", pass))
+		},
+		onPassEnd: func(root *tree.Node, pass int) {
+			record(fmt.Sprintf("pass end %d", pass))
+		},
+		onLeafSelection: func(leaf *tree.Node) {
+			record("leaf selection")
+		},
+		onLeafExpansion: func(leaf *tree.Node, children tree.NodeGroup) {
+			record(fmt.Sprintf("leaf expansion %d", len(children)))
+		},
+		onSimulation: func(node *tree.Node, state tree.NodeState) {
+			record(fmt.Sprintf("simulation %d", state.WinCount))
+		},
+		onMerge: func(root *tree.Node, rootCopy *tree.Node) {
+			record("merge")
+		},
+		onMoveSelection: func(root *tree.Node, node *tree.Node) {
+			record("move selection")
+		},
+	}
+}
+
+func TestGroupObserver(test *testing.T) {
+	var events []string
+	group := NewGroupObserver(
+		newRecordingObserver("one", &events),
+		newRecordingObserver("two", &events),
+	)
+
+	node := &tree.Node{}
+	group.OnSearchStart(node)
+	group.OnSearchEnd(node)
+	group.OnPassStart(node, 1)
+	group.OnPassEnd(node, 1)
+	group.OnLeafSelection(node)
+	group.OnLeafExpansion(node, tree.NodeGroup{node, node})
+	group.OnSimulation(node, tree.NodeState{GameCount: 1, WinCount: 1})
+	group.OnMerge(node, node)
+	group.OnMoveSelection(node, node)
+
+	wantEvents := []string{
+		"one: search start",
+		"two: search start",
+		"one: search end",
+		"two: search end",
+		"one: pass start 1",
+		"two: pass start 1",
+		"one: pass end 1",
+		"two: pass end 1",
+		"one: leaf selection",
+		"two: leaf selection",
+		"one: leaf expansion 2",
+		"two: leaf expansion 2",
+		"one: simulation 1",
+		"two: simulation 1",
+		"one: merge",
+		"two: merge",
+		"one: move selection",
+		"two: move selection",
+	}
+	if !reflect.DeepEqual(events, wantEvents) {
+		test.Fail()
+	}
+}
@@ -0,0 +1,95 @@
+package observers
+
+import (
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// Observer ...
+//
+// It's notified about steps of building of a tree and of searching of a move.
+// Its methods are called synchronously in goroutines of building, so they
+// should be fast and shouldn't modify passed nodes. If it's used inside
+// builders.ParallelBuilder, it should be safe for concurrent use.
+//
+type Observer interface {
+	// It's called by searchers.MoveSearcher before building of a tree.
+	OnSearchStart(root *tree.Node)
+	// It's called by searchers.MoveSearcher after building of a tree,
+	// even if the building has failed.
+	OnSearchEnd(root *tree.Node)
+	// It's called by builders.IterativeBuilder before each pass.
+	OnPassStart(root *tree.Node, pass int)
+	// It's called by builders.IterativeBuilder after each pass.
+	OnPassEnd(root *tree.Node, pass int)
+	// It's called by builders.TreeBuilder after selection of a leaf.
+	OnLeafSelection(leaf *tree.Node)
+	// It's called by builders.TreeBuilder, if the leaf has been expanded.
+	OnLeafExpansion(leaf *tree.Node, children tree.NodeGroup)
+	// It's called by builders.TreeBuilder for each simulated node.
+	// The state is from the point of view of the player that made the move
+	// of the node, i.e. like the state of the node.
+	OnSimulation(node *tree.Node, state tree.NodeState)
+	// It's called by builders.ParallelBuilder after merging of each copy
+	// of the root.
+	OnMerge(root *tree.Node, rootCopy *tree.Node)
+	// It's called by searchers.MoveSearcher after selection of a move.
+	OnMoveSelection(root *tree.Node, node *tree.Node)
+}
+
+// NopObserver ...
+//
+// It ignores all notifications. It can be embedded to implement only
+// the needed methods of Observer.
+//
+type NopObserver struct{}
+
+// OnSearchStart ...
+func (observer NopObserver) OnSearchStart(root *tree.Node) {}
+
+// OnSearchEnd ...
+func (observer NopObserver) OnSearchEnd(root *tree.Node) {}
+
+// OnPassStart ...
+func (observer NopObserver) OnPassStart(root *tree.Node, pass int) {}
+
+// OnPassEnd ...
+func (observer NopObserver) OnPassEnd(root *tree.Node, pass int) {}
+
+// OnLeafSelection ...
+func (observer NopObserver) OnLeafSelection(leaf *tree.Node) {}
+
+// OnLeafExpansion ...
+func (observer NopObserver) OnLeafExpansion(
+	leaf *tree.Node,
+	children tree.NodeGroup,
+) {
+}
+
+// OnSimulation ...
+func (observer NopObserver) OnSimulation(
+	node *tree.Node,
+	state tree.NodeState,
+) {
+}
+
+// OnMerge ...
+func (observer NopObserver) OnMerge(root *tree.Node, rootCopy *tree.Node) {}
+
+// OnMoveSelection ...
+func (observer NopObserver) OnMoveSelection(
+	root *tree.Node,
+	node *tree.Node,
+) {
+}
+
+// OrNop ...
+//
+// It returns NopObserver, if the observer is nil, and the observer otherwise.
+//
+func OrNop(observer Observer) Observer {
+	if observer == nil {
+		return NopObserver{}
+	}
+
+	return observer
+}
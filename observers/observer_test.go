@@ -0,0 +1,36 @@
+package observers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestOrNop(test *testing.T) {
+	type args struct {
+		observer Observer
+	}
+	type data struct {
+		args    args
+		wantNop bool
+	}
+
+	for _, data := range []data{
+		{
+			args:    args{observer: nil},
+			wantNop: true,
+		},
+		{
+			args:    args{observer: NewGroupObserver()},
+			wantNop: false,
+		},
+	} {
+		got := OrNop(data.args.observer)
+
+		if _, ok := got.(NopObserver); ok != data.wantNop {
+			test.Fail()
+		}
+		if !data.wantNop && !reflect.DeepEqual(got, data.args.observer) {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,122 @@
+package records
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+// ...
+var (
+	ErrInvalidRecord = errors.New("invalid record")
+)
+
+const sgfCoordinates = "abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+// Record ...
+//
+// It describes a game played from the empty board; the black player moves
+// first. The winner and the resignation flag are used for a finished game
+// only.
+//
+type Record struct {
+	Size          models.Size
+	BlackPlayer   string
+	WhitePlayer   string
+	Moves         []models.Move
+	IsFinished    bool
+	Winner        models.Color
+	IsResignation bool
+}
+
+// WriteSGF ...
+//
+// It writes the record in the SGF format (FF[4], GM[1]). The result is
+// written as "B+" or "W+" for a win by a capture and as "B+R" or "W+R"
+// for a win by a resignation; it's omitted for an unfinished game.
+//
+// Returned error can be ErrInvalidRecord or an error of the writer.
+//
+func (record Record) WriteSGF(writer io.Writer) error {
+	size := record.Size
+	if size.Width < 1 || size.Width > len(sgfCoordinates) ||
+		size.Height < 1 || size.Height > len(sgfCoordinates) {
+		return ErrInvalidRecord
+	}
+
+	bufferedWriter := bufio.NewWriter(writer)
+	bufferedWriter.WriteString("(;FF[4]GM[1]CA[UTF-8]") // nolint: errcheck
+	sizeValue := fmt.Sprintf("%d", size.Width)
+	if size.Width != size.Height {
+		sizeValue += fmt.Sprintf(":%d", size.Height)
+	}
+	writeSGFText(bufferedWriter, "SZ", sizeValue)
+	writeSGFText(bufferedWriter, "PB", record.BlackPlayer)
+	writeSGFText(bufferedWriter, "PW", record.WhitePlayer)
+	if record.IsFinished {
+		result, err := sgfColor(record.Winner)
+		if err != nil {
+			return err
+		}
+
+		result += "+"
+		if record.IsResignation {
+			result += "R"
+		}
+		writeSGFText(bufferedWriter, "RE", result)
+	}
+
+	for index, move := range record.Moves {
+		color, err := sgfColor(move.Color)
+		if err != nil {
+			return err
+		}
+		if move.Color != moveColor(index) || !size.HasPoint(move.Point) {
+			return ErrInvalidRecord
+		}
+
+		fmt.Fprintf( // nolint: errcheck
+			bufferedWriter,
+			";%s[%c%c]",
+			color,
+			sgfCoordinates[move.Point.Column],
+			sgfCoordinates[move.Point.Row],
+		)
+	}
+	bufferedWriter.WriteString(")\n") // nolint: errcheck
+
+	return bufferedWriter.Flush()
+}
+
+func writeSGFText(writer *bufio.Writer, name string, value string) {
+	if value == "" {
+		return
+	}
+
+	replacer := strings.NewReplacer(`\`, `\\`, "]", `\]`)
+	value = replacer.Replace(value)
+	fmt.Fprintf(writer, "%s[%s]", name, value) // nolint: errcheck
+}
+
+func sgfColor(color models.Color) (string, error) {
+	switch color {
+	case models.Black:
+		return "B", nil
+	case models.White:
+		return "W", nil
+	default:
+		return "", ErrInvalidRecord
+	}
+}
+
+func moveColor(index int) models.Color {
+	if index%2 == 0 {
+		return models.Black
+	}
+
+	return models.White
+}
@@ -0,0 +1,92 @@
+package records
+
+import (
+	"bytes"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+func TestRecordWriteSGF(test *testing.T) {
+	type data struct {
+		record   Record
+		wantData string
+		wantErr  error
+	}
+
+	moves := []models.Move{
+		{Color: models.Black, Point: models.Point{Column: 0, Row: 0}},
+		{Color: models.White, Point: models.Point{Column: 1, Row: 0}},
+		{Color: models.Black, Point: models.Point{Column: 2, Row: 1}},
+	}
+	for _, data := range []data{
+		{
+			record: Record{
+				Size:        models.Size{Width: 3, Height: 3},
+				BlackPlayer: "human",
+				WhitePlayer: "engine [novice]",
+				Moves:       moves,
+				IsFinished:  true,
+				Winner:      models.White,
+			},
+			wantData: "(;FF[4]GM[1]CA[UTF-8]SZ[3]PB[human]PW[engine [novice\\]]" +
+				"RE[W+];B[aa];W[ba];B[cb])\n",
+			wantErr: nil,
+		},
+		{
+			record: Record{
+				Size:          models.Size{Width: 3, Height: 2},
+				Moves:         moves[:1],
+				IsFinished:    true,
+				Winner:        models.Black,
+				IsResignation: true,
+			},
+			wantData: "(;FF[4]GM[1]CA[UTF-8]SZ[3:2]RE[B+R];B[aa])\n",
+			wantErr:  nil,
+		},
+		{
+			record: Record{
+				Size:  models.Size{Width: 3, Height: 3},
+				Moves: nil,
+			},
+			wantData: "(;FF[4]GM[1]CA[UTF-8]SZ[3])\n",
+			wantErr:  nil,
+		},
+		// the invalid size
+		{
+			record: Record{
+				Size: models.Size{Width: 0, Height: 3},
+			},
+			wantData: "",
+			wantErr:  ErrInvalidRecord,
+		},
+		// the invalid order of moves
+		{
+			record: Record{
+				Size:  models.Size{Width: 3, Height: 3},
+				Moves: moves[1:],
+			},
+			wantData: "",
+			wantErr:  ErrInvalidRecord,
+		},
+		// the move outside the board
+		{
+			record: Record{
+				Size:  models.Size{Width: 2, Height: 2},
+				Moves: moves,
+			},
+			wantData: "",
+			wantErr:  ErrInvalidRecord,
+		},
+	} {
+		var buffer bytes.Buffer
+		err := data.record.WriteSGF(&buffer)
+
+		if err == nil && buffer.String() != data.wantData {
+			test.Fail()
+		}
+		if err != data.wantErr {
+			test.Fail()
+		}
+	}
+}
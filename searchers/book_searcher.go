@@ -0,0 +1,119 @@
+package searchers
+
+import (
+	"math/rand"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/boards"
+	"github.com/thewizardplusplus/go-atari-montecarlo/book"
+	"github.com/thewizardplusplus/go-atari-montecarlo/selectors"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// BookSearcher ...
+//
+// It consults the opening book before searching a move. Book moves are taken
+// into account only if they are legal and their game count isn't less than
+// the minimal one.
+//
+// The depth of a position is the count of stones on its board. The book isn't
+// consulted for positions with a depth not less than the maximal one; if the
+// maximal depth is zero, the book is always consulted.
+//
+// A book move is selected by selectors.TemperatureSelector, so if
+// the temperature is zero, the most played book move is selected.
+//
+type BookSearcher struct {
+	Searcher         MoveSearcher
+	Book             *book.Book
+	MaximalDepth     int
+	MinimalGameCount int
+	Temperature      float64
+	// If it's nil, the global randomizer of the math/rand package is used.
+	// Otherwise, the searcher isn't safe for concurrent use.
+	Randomizer *rand.Rand
+}
+
+// SearchMove ...
+//
+// If a book move is selected, it returns a new node that isn't added
+// to children of the root; the node state is copied from the book.
+//
+// Returned error can be models.ErrAlreadyLoss, models.ErrAlreadyWin,
+// ErrFailedBuilding or another error of the move generator on the root
+// (e.g. filters.ErrNoAllowedMoves).
+//
+func (searcher BookSearcher) SearchMove(root *tree.Node) (*tree.Node, error) {
+	generator := searcher.Searcher.MoveGenerator
+	legalMoves, err := generator.LegalMoves(root.Storage, root.Move)
+	if err != nil {
+		return nil, err
+	}
+
+	if searcher.MaximalDepth == 0 ||
+		boards.StoneCount(root.Storage) < searcher.MaximalDepth {
+		statistics := searcher.bookMoves(root, legalMoves)
+		if len(statistics) != 0 {
+			selected := searcher.selectMove(statistics)
+			move := models.Move{
+				Color: root.Move.Color.Negative(),
+				Point: selected.Point,
+			}
+			node := &tree.Node{
+				Parent:  root,
+				Move:    move,
+				Storage: root.Storage.ApplyMove(move),
+				State:   selected.State,
+			}
+			return node, nil
+		}
+	}
+
+	return searcher.Searcher.SearchMove(root)
+}
+
+func (searcher BookSearcher) bookMoves(
+	root *tree.Node,
+	legalMoves []models.Move,
+) []book.MoveStatistics {
+	legalPoints := make(map[models.Point]struct{})
+	for _, move := range legalMoves {
+		legalPoints[move.Point] = struct{}{}
+	}
+
+	var statistics []book.MoveStatistics
+	nextColor := root.Move.Color.Negative()
+	for _, move := range searcher.Book.Moves(root.Storage, nextColor) {
+		if _, ok := legalPoints[move.Point]; !ok ||
+			move.State.GameCount == 0 ||
+			move.State.GameCount < searcher.MinimalGameCount {
+			continue
+		}
+
+		statistics = append(statistics, move)
+	}
+
+	return statistics
+}
+
+func (searcher BookSearcher) selectMove(
+	statistics []book.MoveStatistics,
+) book.MoveStatistics {
+	nodes := make(tree.NodeGroup, 0, len(statistics))
+	for _, move := range statistics {
+		nodes = append(nodes, &tree.Node{State: move.State})
+	}
+
+	selector := selectors.TemperatureSelector{
+		Temperature: searcher.Temperature,
+		Randomizer:  searcher.Randomizer,
+	}
+	selectedNode := selector.SelectNode(nodes)
+	for index, node := range nodes {
+		if node == selectedNode {
+			return statistics[index]
+		}
+	}
+
+	return statistics[0]
+}
@@ -0,0 +1,169 @@
+package searchers
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/book"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestBookSearcherSearchMove(test *testing.T) {
+	type fields struct {
+		maximalDepth     int
+		minimalGameCount int
+		temperature      float64
+	}
+	type data struct {
+		fields   fields
+		root     *tree.Node
+		wantMove models.Move
+	}
+
+	board := models.NewBoard(models.Size{Width: 3, Height: 3})
+	firstMove := models.Move{Color: models.Black, Point: models.Point{}}
+	secondMove :=
+		models.Move{Color: models.Black, Point: models.Point{Column: 1}}
+	searchedMove :=
+		models.Move{Color: models.Black, Point: models.Point{Column: 2}}
+
+	openingBook := book.NewBook()
+	openingBook.AddMove(board, firstMove, tree.NodeState{GameCount: 100})
+	openingBook.AddMove(board, secondMove, tree.NodeState{GameCount: 1})
+
+	for _, data := range []data{
+		{
+			fields: fields{},
+			root: &tree.Node{
+				Move:    models.NewPreliminaryMove(models.Black),
+				Storage: board,
+			},
+			wantMove: firstMove,
+		},
+		{
+			fields: fields{temperature: 0.1},
+			root: &tree.Node{
+				Move:    models.NewPreliminaryMove(models.Black),
+				Storage: board,
+			},
+			wantMove: firstMove,
+		},
+		{
+			fields: fields{minimalGameCount: 1000},
+			root: &tree.Node{
+				Move:    models.NewPreliminaryMove(models.Black),
+				Storage: board,
+			},
+			wantMove: searchedMove,
+		},
+		{
+			fields: fields{maximalDepth: 1},
+			root: &tree.Node{
+				Move:    models.NewPreliminaryMove(models.Black),
+				Storage: board,
+			},
+			wantMove: firstMove,
+		},
+		{
+			fields: fields{maximalDepth: 1},
+			root: &tree.Node{
+				Move: models.NewPreliminaryMove(models.Black),
+				Storage: board.ApplyMove(models.Move{
+					Color: models.White,
+					Point: models.Point{Row: 2},
+				}),
+			},
+			wantMove: searchedMove,
+		},
+	} {
+		searcher := BookSearcher{
+			Searcher: MoveSearcher{
+				MoveGenerator: models.MoveGenerator{},
+				Builder: MockBuilder{
+					pass: func(root *tree.Node) {
+						root.Children = tree.NodeGroup{
+							{Parent: root, Move: searchedMove},
+						}
+					},
+				},
+				NodeSelector: MockNodeSelector{
+					selectNode: func(nodes tree.NodeGroup) *tree.Node {
+						return nodes[0]
+					},
+				},
+			},
+			Book:             openingBook,
+			MaximalDepth:     data.fields.maximalDepth,
+			MinimalGameCount: data.fields.minimalGameCount,
+			Temperature:      data.fields.temperature,
+		}
+		node, err := searcher.SearchMove(data.root)
+
+		if !reflect.DeepEqual(node.Move, data.wantMove) {
+			test.Fail()
+		}
+		if node.Parent != data.root {
+			test.Fail()
+		}
+		if err != nil {
+			test.Fail()
+		}
+	}
+}
+
+func TestBookSearcherSearchMove_withRandomizer(test *testing.T) {
+	board := models.NewBoard(models.Size{Width: 3, Height: 3})
+	openingBook := book.NewBook()
+	for _, point := range board.Size().Points() {
+		move := models.Move{Color: models.Black, Point: point}
+		openingBook.AddMove(board, move, tree.NodeState{GameCount: 1})
+	}
+
+	var points [2][]models.Point
+	for index := range points {
+		searcher := BookSearcher{
+			Searcher:    MoveSearcher{MoveGenerator: models.MoveGenerator{}},
+			Book:        openingBook,
+			Temperature: 1,
+			Randomizer:  rand.New(rand.NewSource(1)),
+		}
+		for i := 0; i < 10; i++ {
+			node, _ := searcher.SearchMove(&tree.Node{
+				Move:    models.NewPreliminaryMove(models.Black),
+				Storage: board,
+			})
+
+			points[index] = append(points[index], node.Move.Point)
+		}
+	}
+
+	if !reflect.DeepEqual(points[0], points[1]) {
+		test.Fail()
+	}
+}
+
+func TestBookSearcherSearchMove_withError(test *testing.T) {
+	board := models.NewBoard(models.Size{Width: 3, Height: 3})
+	board = board.ApplyMove(models.Move{Color: models.Black})
+	for _, point := range []models.Point{{Column: 1}, {Row: 1}} {
+		board = board.ApplyMove(models.Move{Color: models.White, Point: point})
+	}
+
+	searcher := BookSearcher{
+		Searcher: MoveSearcher{MoveGenerator: models.MoveGenerator{}},
+		Book:     book.NewBook(),
+	}
+	node, err := searcher.SearchMove(&tree.Node{
+		Move:    models.Move{Color: models.White, Point: models.Point{Row: 1}},
+		Storage: board,
+	})
+
+	if node != nil {
+		test.Fail()
+	}
+	if err != models.ErrAlreadyLoss {
+		test.Fail()
+	}
+}
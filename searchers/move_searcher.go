@@ -4,7 +4,9 @@ import (
 	"errors"
 
 	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/analysis"
 	"github.com/thewizardplusplus/go-atari-montecarlo/builders"
+	"github.com/thewizardplusplus/go-atari-montecarlo/observers"
 	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
 )
 
@@ -18,12 +20,15 @@ type MoveSearcher struct {
 	MoveGenerator models.Generator
 	Builder       builders.Builder
 	NodeSelector  tree.NodeSelector
+	// If it's nil, observers.NopObserver is used.
+	Observer observers.Observer
 }
 
 // SearchMove ...
 //
-// Returned error can be models.ErrAlreadyLoss, models.ErrAlreadyWin or
-// ErrFailedBuilding only.
+// Returned error can be models.ErrAlreadyLoss, models.ErrAlreadyWin,
+// ErrFailedBuilding or another error of the move generator on the root
+// (e.g. filters.ErrNoAllowedMoves).
 //
 func (searcher MoveSearcher) SearchMove(root *tree.Node) (*tree.Node, error) {
 	_, err := searcher.MoveGenerator.LegalMoves(root.Storage, root.Move)
@@ -31,11 +36,59 @@ func (searcher MoveSearcher) SearchMove(root *tree.Node) (*tree.Node, error) {
 		return nil, err
 	}
 
+	observer := observers.OrNop(searcher.Observer)
+	observer.OnSearchStart(root)
 	searcher.Builder.Pass(root)
+	observer.OnSearchEnd(root)
 	if len(root.Children) == 0 {
 		return nil, ErrFailedBuilding
 	}
 
 	node := searcher.NodeSelector.SelectNode(root.Children)
+	observer.OnMoveSelection(root, node)
+
 	return node, nil
 }
+
+// SearchVariations ...
+//
+// It searches the best moves like the method SearchMove() and returns
+// variations of the best root children ranked by game counts
+// (see analysis.NewVariations()).
+//
+// Returned error can be models.ErrAlreadyLoss, models.ErrAlreadyWin,
+// ErrFailedBuilding or another error of the move generator on the root
+// (e.g. filters.ErrNoAllowedMoves).
+//
+func (searcher MoveSearcher) SearchVariations(
+	root *tree.Node,
+	count int,
+) ([]analysis.Variation, error) {
+	if _, err := searcher.SearchMove(root); err != nil {
+		return nil, err
+	}
+
+	variations :=
+		analysis.NewVariations(root, count, analysis.DefaultConfidenceScore)
+	return variations, nil
+}
+
+// SearchPosition ...
+//
+// It searches a move in the position with an explicit side to move.
+// The found node is a child of a new root.
+//
+// Returned error can be tree.ErrInconsistentHistory, models.ErrAlreadyLoss,
+// models.ErrAlreadyWin, ErrFailedBuilding or another error of the move
+// generator on the root (e.g. filters.ErrNoAllowedMoves).
+//
+func (searcher MoveSearcher) SearchPosition(
+	position tree.Position,
+) (*tree.Node, error) {
+	root, err := position.NewRoot()
+	if err != nil {
+		return nil, err
+	}
+
+	return searcher.SearchMove(root)
+}
@@ -35,6 +35,7 @@ type searchSettings struct {
 	parallelSimulator      bool
 	parallelBulkySimulator bool
 	parallelBuilder        bool
+	nodePool               *tree.NodePool
 }
 
 func search(
@@ -81,6 +82,7 @@ func search(
 			NodeSelector:  generalSelector,
 			MoveGenerator: generator,
 			Simulator:     bulkySimulator,
+			NodePool:      settings.nodePool,
 		},
 		Terminator: terminator,
 	}
@@ -88,6 +90,7 @@ func search(
 		builder = builders.ParallelBuilder{
 			Builder:     builder,
 			Concurrency: runtime.NumCPU(),
+			NodePool:    settings.nodePool,
 		}
 	}
 
@@ -105,7 +108,10 @@ func search(
 		return models.Move{}, err
 	}
 
-	return node.Move, nil
+	move := node.Move
+	settings.nodePool.Free(root.Children...)
+
+	return move, nil
 }
 
 func BenchmarkSearch_5Passes(benchmark *testing.B) {
@@ -335,3 +341,67 @@ func BenchmarkSearch_20PassesAndParallelBuilder(benchmark *testing.B) {
 		)
 	}
 }
+
+func BenchmarkSearch_20PassesAndAllocations(benchmark *testing.B) {
+	benchmark.ReportAllocs()
+
+	for i := 0; i < benchmark.N; i++ {
+		// nolint: errcheck
+		search(
+			initialBoard,
+			initialColor,
+			searchSettings{
+				maximalPass: 20,
+			},
+		)
+	}
+}
+
+func BenchmarkSearch_100PassesAndAllocations(benchmark *testing.B) {
+	benchmark.ReportAllocs()
+
+	for i := 0; i < benchmark.N; i++ {
+		// nolint: errcheck
+		search(
+			initialBoard,
+			initialColor,
+			searchSettings{
+				maximalPass: 100,
+			},
+		)
+	}
+}
+
+func BenchmarkSearch_20PassesAndNodePool(benchmark *testing.B) {
+	benchmark.ReportAllocs()
+
+	nodePool := new(tree.NodePool)
+	for i := 0; i < benchmark.N; i++ {
+		// nolint: errcheck
+		search(
+			initialBoard,
+			initialColor,
+			searchSettings{
+				maximalPass: 20,
+				nodePool:    nodePool,
+			},
+		)
+	}
+}
+
+func BenchmarkSearch_100PassesAndNodePool(benchmark *testing.B) {
+	benchmark.ReportAllocs()
+
+	nodePool := new(tree.NodePool)
+	for i := 0; i < benchmark.N; i++ {
+		// nolint: errcheck
+		search(
+			initialBoard,
+			initialColor,
+			searchSettings{
+				maximalPass: 100,
+				nodePool:    nodePool,
+			},
+		)
+	}
+}
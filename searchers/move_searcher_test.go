@@ -1,11 +1,14 @@
 package searchers
 
 import (
+	"math"
 	"reflect"
 	"testing"
 
 	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/analysis"
 	"github.com/thewizardplusplus/go-atari-montecarlo/builders"
+	"github.com/thewizardplusplus/go-atari-montecarlo/observers"
 	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
 )
 
@@ -33,6 +36,41 @@ func (selector MockNodeSelector) SelectNode(nodes tree.NodeGroup) *tree.Node {
 	return selector.selectNode(nodes)
 }
 
+type MockObserver struct {
+	observers.NopObserver
+
+	onSearchStart   func(root *tree.Node)
+	onSearchEnd     func(root *tree.Node)
+	onMoveSelection func(root *tree.Node, node *tree.Node)
+}
+
+func (observer MockObserver) OnSearchStart(root *tree.Node) {
+	if observer.onSearchStart == nil {
+		panic("not implemented")
+	}
+
+	observer.onSearchStart(root)
+}
+
+func (observer MockObserver) OnSearchEnd(root *tree.Node) {
+	if observer.onSearchEnd == nil {
+		panic("not implemented")
+	}
+
+	observer.onSearchEnd(root)
+}
+
+func (observer MockObserver) OnMoveSelection(
+	root *tree.Node,
+	node *tree.Node,
+) {
+	if observer.onMoveSelection == nil {
+		panic("not implemented")
+	}
+
+	observer.onMoveSelection(root, node)
+}
+
 func TestMoveSearcherSearchMove(test *testing.T) {
 	type fields struct {
 		moveGenerator models.Generator
@@ -261,3 +299,213 @@ func TestMoveSearcherSearchMove(test *testing.T) {
 		}
 	}
 }
+
+func TestMoveSearcherSearchPosition(test *testing.T) {
+	lastMove := models.Move{Color: models.White, Point: models.Point{}}
+	storage := models.NewBoard(models.Size{Width: 3, Height: 3}).
+		ApplyMove(lastMove)
+
+	type data struct {
+		position     tree.Position
+		wantPrevious models.Move
+		wantErr      error
+	}
+
+	for _, data := range []data{
+		{
+			position: tree.Position{
+				Storage:    storage,
+				SideToMove: models.Black,
+			},
+			wantPrevious: models.NewPreliminaryMove(models.Black),
+			wantErr:      nil,
+		},
+		{
+			position: tree.Position{
+				Storage:    storage,
+				SideToMove: models.Black,
+				History:    []models.Move{lastMove},
+			},
+			wantPrevious: lastMove,
+			wantErr:      nil,
+		},
+		{
+			position: tree.Position{
+				Storage:    storage,
+				SideToMove: models.White,
+				History:    []models.Move{lastMove},
+			},
+			wantErr: tree.ErrInconsistentHistory,
+		},
+	} {
+		var passedRoot *tree.Node
+		searcher := MoveSearcher{
+			MoveGenerator: models.MoveGenerator{},
+			Builder: MockBuilder{
+				pass: func(root *tree.Node) {
+					passedRoot = root
+					root.Children = tree.NodeGroup{{Parent: root}}
+				},
+			},
+			NodeSelector: MockNodeSelector{
+				selectNode: func(nodes tree.NodeGroup) *tree.Node {
+					return nodes[0]
+				},
+			},
+		}
+		node, err := searcher.SearchPosition(data.position)
+
+		if data.wantErr == nil {
+			if node == nil || node.Parent != passedRoot ||
+				passedRoot.Move != data.wantPrevious ||
+				!reflect.DeepEqual(passedRoot.Storage, storage) {
+				test.Fail()
+			}
+		} else if node != nil {
+			test.Fail()
+		}
+		if err != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestMoveSearcherSearchMove_withObserver(test *testing.T) {
+	var events []string
+	searcher := MoveSearcher{
+		MoveGenerator: models.MoveGenerator{},
+		Builder: MockBuilder{
+			pass: func(root *tree.Node) {
+				events = append(events, "pass")
+				root.Children = tree.NodeGroup{&tree.Node{Parent: root}}
+			},
+		},
+		NodeSelector: MockNodeSelector{
+			selectNode: func(nodes tree.NodeGroup) *tree.Node { return nodes[0] },
+		},
+		Observer: MockObserver{
+			onSearchStart: func(root *tree.Node) {
+				events = append(events, "search start")
+			},
+			onSearchEnd: func(root *tree.Node) {
+				events = append(events, "search end")
+			},
+			onMoveSelection: func(root *tree.Node, node *tree.Node) {
+				if node != root.Children[0] {
+					test.Fail()
+				}
+
+				events = append(events, "move selection")
+			},
+		},
+	}
+
+	root := &tree.Node{
+		Move:    models.NewPreliminaryMove(models.Black),
+		Storage: models.NewBoard(models.Size{Width: 3, Height: 3}),
+	}
+	_, err := searcher.SearchMove(root)
+
+	wantEvents := []string{"search start", "pass", "search end", "move selection"}
+	if err != nil {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(events, wantEvents) {
+		test.Fail()
+	}
+}
+
+func TestMoveSearcherSearchVariations(test *testing.T) {
+	type data struct {
+		storage        models.StoneStorage
+		wantVariations []analysis.Variation
+		wantErr        error
+	}
+
+	// it rounds bounds of confidence intervals for comparison
+	round := func(value float64) float64 {
+		return math.Round(value*1e4) / 1e4
+	}
+	newChild := func(root *tree.Node, column int, state tree.NodeState) {
+		root.Children = append(root.Children, &tree.Node{
+			Parent: root,
+			Move: models.Move{
+				Color: models.Black,
+				Point: models.Point{Column: column, Row: 0},
+			},
+			State: state,
+		})
+	}
+	for _, data := range []data{
+		{
+			storage: models.NewBoard(models.Size{Width: 3, Height: 1}),
+			wantVariations: []analysis.Variation{
+				{
+					Move: models.Move{
+						Color: models.Black,
+						Point: models.Point{Column: 1, Row: 0},
+					},
+					State:      tree.NodeState{GameCount: 3, WinCount: 3},
+					LowerBound: 0.4385,
+					UpperBound: 1,
+					PrincipalVariation: []models.Move{
+						{Color: models.Black, Point: models.Point{Column: 1, Row: 0}},
+					},
+				},
+				{
+					Move: models.Move{
+						Color: models.Black,
+						Point: models.Point{Column: 0, Row: 0},
+					},
+					State:      tree.NodeState{GameCount: 2, WinCount: 0},
+					LowerBound: 0,
+					UpperBound: 0.6576,
+					PrincipalVariation: []models.Move{
+						{Color: models.Black, Point: models.Point{Column: 0, Row: 0}},
+					},
+				},
+			},
+			wantErr: nil,
+		},
+		{
+			storage: models.NewBoard(models.Size{Width: 1, Height: 1}).
+				ApplyMove(models.Move{Color: models.White}),
+			wantVariations: nil,
+			wantErr:        models.ErrAlreadyWin,
+		},
+	} {
+		searcher := MoveSearcher{
+			MoveGenerator: models.MoveGenerator{},
+			Builder: MockBuilder{
+				pass: func(root *tree.Node) {
+					newChild(root, 0, tree.NodeState{GameCount: 2, WinCount: 0})
+					newChild(root, 1, tree.NodeState{GameCount: 3, WinCount: 3})
+					newChild(root, 2, tree.NodeState{GameCount: 1, WinCount: 1})
+				},
+			},
+			NodeSelector: MockNodeSelector{
+				selectNode: func(nodes tree.NodeGroup) *tree.Node { return nodes[0] },
+			},
+		}
+		root := &tree.Node{
+			Move:    models.NewPreliminaryMove(models.Black),
+			Storage: data.storage,
+		}
+		gotVariations, gotErr := searcher.SearchVariations(root, 2)
+
+		if len(gotVariations) != len(data.wantVariations) {
+			test.Fail()
+		}
+		for index := range gotVariations {
+			gotVariation := gotVariations[index]
+			gotVariation.LowerBound = round(gotVariation.LowerBound)
+			gotVariation.UpperBound = round(gotVariation.UpperBound)
+			if !reflect.DeepEqual(gotVariation, data.wantVariations[index]) {
+				test.Fail()
+			}
+		}
+		if gotErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
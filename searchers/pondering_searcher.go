@@ -0,0 +1,124 @@
+package searchers
+
+import (
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/builders"
+	"github.com/thewizardplusplus/go-atari-montecarlo/builders/terminators"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// PonderingSearcher ...
+//
+// After searching a move, it continues building a copy of the tree
+// of the found node in the background during the opponent's turn. When
+// the opponent's move arrives, the method StopPondering() returns
+// the corresponding child of the copy, so the accumulated games are used
+// in the next search.
+//
+// It isn't safe for concurrent use.
+//
+type PonderingSearcher struct {
+	Searcher MoveSearcher
+	// It should perform a single pass, e.g. builders.TreeBuilder;
+	// it's repeated until pondering is stopped.
+	PonderingBuilder builders.Builder
+	// If it's nil, discarded nodes are left to the garbage collector.
+	NodePool *tree.NodePool
+
+	ponderingRoot *tree.Node
+	terminator    *terminators.ManualTerminator
+	done          chan struct{}
+}
+
+// SearchMove ...
+//
+// It stops the previous pondering, if it's still in progress, and frees
+// the pondered tree.
+//
+// Pondering is performed on a copy of the found node owned by the searcher,
+// so neither the root nor the found node are changed or freed by the searcher.
+//
+// Returned error can be models.ErrAlreadyLoss, models.ErrAlreadyWin,
+// ErrFailedBuilding or another error of the move generator on the root
+// (e.g. filters.ErrNoAllowedMoves).
+//
+func (searcher *PonderingSearcher) SearchMove(
+	root *tree.Node,
+) (*tree.Node, error) {
+	if ponderingRoot := searcher.stopPondering(); ponderingRoot != nil {
+		searcher.NodePool.Free(ponderingRoot)
+	}
+
+	node, err := searcher.Searcher.SearchMove(root)
+	if err != nil {
+		return nil, err
+	}
+
+	searcher.startPondering(node.DeepCopy())
+	return node, nil
+}
+
+// StopPondering ...
+//
+// It returns the child of the pondered node that corresponds to the passed
+// move; the child is detached from its parent and is owned by the caller
+// since then. If there is no such child, a new node is returned. The rest
+// of the pondered tree is freed.
+//
+// If pondering isn't in progress, it returns nil.
+//
+func (searcher *PonderingSearcher) StopPondering(move models.Move) *tree.Node {
+	root := searcher.stopPondering()
+	if root == nil {
+		return nil
+	}
+
+	var nextRoot *tree.Node
+	for _, child := range root.Children {
+		if child.Move == move {
+			nextRoot = child
+			continue
+		}
+
+		searcher.NodePool.Free(child)
+	}
+	if nextRoot == nil {
+		nextRoot = &tree.Node{Move: move, Storage: root.Storage.ApplyMove(move)}
+	}
+
+	root.Children = nil
+	searcher.NodePool.Free(root)
+
+	nextRoot.Parent = nil
+	return nextRoot
+}
+
+func (searcher *PonderingSearcher) startPondering(root *tree.Node) {
+	terminator := terminators.NewManualTerminator()
+	builder := builders.IterativeBuilder{
+		Builder:    searcher.PonderingBuilder,
+		Terminator: terminator,
+	}
+	searcher.ponderingRoot = root
+	searcher.terminator = terminator
+	searcher.done = make(chan struct{})
+
+	go func(done chan struct{}) {
+		defer close(done)
+		builder.Pass(root)
+	}(searcher.done)
+}
+
+func (searcher *PonderingSearcher) stopPondering() (root *tree.Node) {
+	if searcher.ponderingRoot == nil {
+		return nil
+	}
+
+	searcher.terminator.Terminate()
+	<-searcher.done
+
+	root = searcher.ponderingRoot
+	searcher.ponderingRoot, searcher.terminator, searcher.done = nil, nil, nil
+
+	return root
+}
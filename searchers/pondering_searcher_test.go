@@ -0,0 +1,244 @@
+package searchers
+
+import (
+	"reflect"
+	"sync"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestPonderingSearcher(test *testing.T) {
+	type args struct {
+		move models.Move
+	}
+	type data struct {
+		args     args
+		wantRoot func(searchedNode *tree.Node) *tree.Node
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				move: models.Move{
+					Color: models.White,
+					Point: models.Point{
+						Column: 1,
+						Row:    1,
+					},
+				},
+			},
+			wantRoot: func(searchedNode *tree.Node) *tree.Node {
+				return &tree.Node{
+					Move: models.Move{
+						Color: models.White,
+						Point: models.Point{
+							Column: 1,
+							Row:    1,
+						},
+					},
+					State: tree.NodeState{
+						GameCount: 3,
+						WinCount:  2,
+					},
+				}
+			},
+		},
+		{
+			args: args{
+				move: models.Move{
+					Color: models.White,
+					Point: models.Point{
+						Column: 2,
+						Row:    2,
+					},
+				},
+			},
+			wantRoot: func(searchedNode *tree.Node) *tree.Node {
+				move := models.Move{
+					Color: models.White,
+					Point: models.Point{
+						Column: 2,
+						Row:    2,
+					},
+				}
+				return &tree.Node{
+					Move:    move,
+					Storage: searchedNode.Storage.ApplyMove(move),
+				}
+			},
+		},
+	} {
+		root := &tree.Node{
+			Move:    models.NewPreliminaryMove(models.Black),
+			Storage: models.NewBoard(models.Size{Width: 3, Height: 3}),
+			State: tree.NodeState{
+				GameCount: 2,
+				WinCount:  1,
+			},
+		}
+
+		var ponderingOnce sync.Once
+		pondered := make(chan struct{})
+		searcher := PonderingSearcher{
+			Searcher: MoveSearcher{
+				MoveGenerator: models.MoveGenerator{},
+				Builder: MockBuilder{
+					pass: func(root *tree.Node) {
+						move := models.Move{
+							Color: models.Black,
+							Point: models.Point{
+								Column: 0,
+								Row:    0,
+							},
+						}
+						root.Children = tree.NodeGroup{
+							&tree.Node{
+								Parent:  root,
+								Move:    move,
+								Storage: root.Storage.ApplyMove(move),
+							},
+						}
+					},
+				},
+				NodeSelector: MockNodeSelector{
+					selectNode: func(nodes tree.NodeGroup) *tree.Node {
+						return nodes[0]
+					},
+				},
+			},
+			PonderingBuilder: MockBuilder{
+				pass: func(root *tree.Node) {
+					ponderingOnce.Do(func() {
+						defer close(pondered)
+
+						root.Children = tree.NodeGroup{
+							&tree.Node{
+								Parent: root,
+								Move: models.Move{
+									Color: models.White,
+									Point: models.Point{
+										Column: 1,
+										Row:    1,
+									},
+								},
+								State: tree.NodeState{
+									GameCount: 3,
+									WinCount:  2,
+								},
+							},
+						}
+					})
+				},
+			},
+		}
+		node, err := searcher.SearchMove(root)
+		if err != nil {
+			test.Fail()
+		}
+		if node.Parent != root || len(root.Children) != 1 {
+			test.Fail()
+		}
+
+		<-pondered
+		got := searcher.StopPondering(data.args.move)
+
+		want := data.wantRoot(node)
+		if !reflect.DeepEqual(got, want) {
+			test.Fail()
+		}
+		if len(node.Children) != 0 {
+			test.Fail()
+		}
+
+		if searcher.StopPondering(data.args.move) != nil {
+			test.Fail()
+		}
+	}
+}
+
+func TestPonderingSearcherSearchMove_withNodePool(test *testing.T) {
+	board := models.NewBoard(models.Size{Width: 3, Height: 3})
+	newRoot := func() *tree.Node {
+		return &tree.Node{
+			Move:    models.NewPreliminaryMove(models.Black),
+			Storage: board,
+		}
+	}
+
+	// +-+-+-+
+	// |W|W|W|
+	// +-+-+-+
+	// |W|W|W|
+	// +-+-+-+
+	// |W|W|W|
+	// +-+-+-+
+	lostBoard := board
+	for _, point := range board.Size().Points() {
+		move := models.Move{Color: models.White, Point: point}
+		lostBoard = lostBoard.ApplyMove(move)
+	}
+	lostRoot := &tree.Node{
+		Move:    models.Move{Color: models.White, Point: models.Point{}},
+		Storage: lostBoard,
+	}
+
+	searcher := PonderingSearcher{
+		Searcher: MoveSearcher{
+			MoveGenerator: models.MoveGenerator{},
+			Builder: MockBuilder{
+				pass: func(root *tree.Node) {
+					move := models.Move{Color: models.Black, Point: models.Point{}}
+					root.Children = tree.NodeGroup{
+						&tree.Node{
+							Parent:  root,
+							Move:    move,
+							Storage: root.Storage.ApplyMove(move),
+							State:   tree.NodeState{GameCount: 1},
+						},
+					}
+				},
+			},
+			NodeSelector: MockNodeSelector{
+				selectNode: func(nodes tree.NodeGroup) *tree.Node {
+					return nodes[0]
+				},
+			},
+		},
+		PonderingBuilder: MockBuilder{
+			pass: func(root *tree.Node) {},
+		},
+		NodePool: &tree.NodePool{},
+	}
+
+	var nodes tree.NodeGroup
+	var wantNodes []tree.Node
+	for _, root := range []*tree.Node{newRoot(), newRoot(), lostRoot} {
+		node, err := searcher.SearchMove(root)
+		if (err == nil) != (root != lostRoot) {
+			test.Fail()
+		}
+		if err != nil {
+			continue
+		}
+
+		nodes = append(nodes, node)
+		wantNodes = append(wantNodes, tree.Node{
+			Parent:  root,
+			Move:    node.Move,
+			Storage: node.Storage,
+			State:   tree.NodeState{GameCount: 1},
+		})
+	}
+
+	// returned nodes are kept by next searches, even if they fail
+	for index, node := range nodes {
+		if !reflect.DeepEqual(*node, wantNodes[index]) {
+			test.Fail()
+		}
+	}
+	if searcher.StopPondering(nodes[0].Move) != nil {
+		test.Fail()
+	}
+}
@@ -0,0 +1,135 @@
+package searchers
+
+import (
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// Outcome ...
+type Outcome int
+
+// ...
+const (
+	UnclearOutcome Outcome = iota
+	WinOutcome
+	LossOutcome
+)
+
+// Report ...
+//
+// It describes a result of a search from the point of view of the player
+// to move. If the game is over, the node is nil and the outcome is exact;
+// otherwise, the outcome is estimated by the win rate of the found node.
+//
+type Report struct {
+	Node                     *tree.Node
+	WinRate                  float64
+	Outcome                  Outcome
+	IsGameOver               bool
+	IsResignationRecommended bool
+}
+
+// ResigningSearcher ...
+//
+// A found move is hopeless, if its win rate is less than the threshold and its
+// game count isn't less than the minimal one; the threshold should be less
+// than 0.5. Resignation is recommended, when hopeless moves are found
+// in the passed count of consecutive searches; the outcome of a hopeless move
+// is estimated as a loss. Symmetrically, the outcome of a move with enough
+// games and a win rate not less than one minus the threshold is estimated
+// as a win.
+//
+// It should be used for a single game, so its searches are consecutive moves
+// of the same player; the method Reset() prepares it for a new game.
+//
+// It isn't safe for concurrent use.
+//
+type ResigningSearcher struct {
+	Searcher         MoveSearcher
+	Threshold        float64
+	MinimalGameCount int
+	MoveCount        int
+
+	hopelessMoveCount int
+}
+
+// SearchMove ...
+//
+// It's an equivalent of the method Search(), but it returns the found node
+// only. If the game is over, the corresponding error is returned.
+//
+// Returned error can be models.ErrAlreadyLoss, models.ErrAlreadyWin,
+// ErrFailedBuilding or another error of the move generator on the root
+// (e.g. filters.ErrNoAllowedMoves).
+//
+func (searcher *ResigningSearcher) SearchMove(
+	root *tree.Node,
+) (*tree.Node, error) {
+	report, err := searcher.Search(root)
+	if err != nil {
+		return nil, err
+	}
+	if report.IsGameOver {
+		if report.Outcome == WinOutcome {
+			return nil, models.ErrAlreadyWin
+		}
+
+		return nil, models.ErrAlreadyLoss
+	}
+
+	return report.Node, nil
+}
+
+// Search ...
+//
+// It searches a move and reports the estimated outcome and the resignation
+// recommendation. If the game is over, it isn't an error.
+//
+// Returned error can be ErrFailedBuilding or another error of the move
+// generator on the root (e.g. filters.ErrNoAllowedMoves).
+//
+func (searcher *ResigningSearcher) Search(root *tree.Node) (Report, error) {
+	node, err := searcher.Searcher.SearchMove(root)
+	switch err {
+	case nil:
+	case models.ErrAlreadyWin:
+		report := Report{WinRate: 1, Outcome: WinOutcome, IsGameOver: true}
+		return report, nil
+	case models.ErrAlreadyLoss:
+		report := Report{WinRate: 0, Outcome: LossOutcome, IsGameOver: true}
+		return report, nil
+	default:
+		return Report{}, err
+	}
+
+	report := Report{Node: node}
+	if node.State.GameCount != 0 {
+		report.WinRate = node.State.WinRate()
+	}
+
+	hasEnoughGames := node.State.GameCount != 0 &&
+		node.State.GameCount >= searcher.MinimalGameCount
+	switch {
+	case hasEnoughGames && report.WinRate < searcher.Threshold:
+		report.Outcome = LossOutcome
+		searcher.hopelessMoveCount++
+	case hasEnoughGames && report.WinRate >= 1-searcher.Threshold:
+		report.Outcome = WinOutcome
+		searcher.hopelessMoveCount = 0
+	default:
+		searcher.hopelessMoveCount = 0
+	}
+
+	report.IsResignationRecommended =
+		searcher.hopelessMoveCount >= searcher.MoveCount &&
+			searcher.hopelessMoveCount != 0
+	return report, nil
+}
+
+// Reset ...
+//
+// It forgets previous searches, e.g. before a new game.
+//
+func (searcher *ResigningSearcher) Reset() {
+	searcher.hopelessMoveCount = 0
+}
@@ -0,0 +1,156 @@
+package searchers
+
+import (
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestResigningSearcherSearch(test *testing.T) {
+	type data struct {
+		states      []tree.NodeState
+		wantReports []Report
+	}
+
+	for _, data := range []data{
+		// hopeless moves in a row
+		{
+			states: []tree.NodeState{
+				{GameCount: 10, WinCount: 1},
+				{GameCount: 10, WinCount: 0},
+				{GameCount: 10, WinCount: 1},
+			},
+			wantReports: []Report{
+				{WinRate: 0.1, Outcome: LossOutcome},
+				{
+					WinRate:                  0,
+					Outcome:                  LossOutcome,
+					IsResignationRecommended: true,
+				},
+				{
+					WinRate:                  0.1,
+					Outcome:                  LossOutcome,
+					IsResignationRecommended: true,
+				},
+			},
+		},
+		// hopeless moves aren't in a row
+		{
+			states: []tree.NodeState{
+				{GameCount: 10, WinCount: 1},
+				{GameCount: 10, WinCount: 5},
+				{GameCount: 10, WinCount: 1},
+			},
+			wantReports: []Report{
+				{WinRate: 0.1, Outcome: LossOutcome},
+				{WinRate: 0.5, Outcome: UnclearOutcome},
+				{WinRate: 0.1, Outcome: LossOutcome},
+			},
+		},
+		// moves with too few games and a winning move
+		{
+			states: []tree.NodeState{
+				{GameCount: 4, WinCount: 0},
+				{GameCount: 0, WinCount: 0},
+				{GameCount: 10, WinCount: 9},
+			},
+			wantReports: []Report{
+				{WinRate: 0, Outcome: UnclearOutcome},
+				{WinRate: 0, Outcome: UnclearOutcome},
+				{WinRate: 0.9, Outcome: WinOutcome},
+			},
+		},
+	} {
+		var index int
+		searcher := ResigningSearcher{
+			Searcher: MoveSearcher{
+				MoveGenerator: models.MoveGenerator{},
+				Builder: MockBuilder{
+					pass: func(root *tree.Node) {
+						root.Children = tree.NodeGroup{
+							&tree.Node{Parent: root, State: data.states[index]},
+						}
+					},
+				},
+				NodeSelector: MockNodeSelector{
+					selectNode: func(nodes tree.NodeGroup) *tree.Node { return nodes[0] },
+				},
+			},
+			Threshold:        0.2,
+			MinimalGameCount: 5,
+			MoveCount:        2,
+		}
+
+		for index = range data.states {
+			root := &tree.Node{
+				Move:    models.NewPreliminaryMove(models.Black),
+				Storage: models.NewBoard(models.Size{Width: 3, Height: 3}),
+			}
+			gotReport, gotErr := searcher.Search(root)
+
+			wantReport := data.wantReports[index]
+			wantReport.Node = root.Children[0]
+			if !reflect.DeepEqual(gotReport, wantReport) {
+				test.Fail()
+			}
+			if gotErr != nil {
+				test.Fail()
+			}
+		}
+
+		searcher.Reset()
+		if searcher.hopelessMoveCount != 0 {
+			test.Fail()
+		}
+	}
+}
+
+func TestResigningSearcherSearch_withGameOver(test *testing.T) {
+	type data struct {
+		storage    models.StoneStorage
+		wantReport Report
+		wantErr    error
+	}
+
+	for _, data := range []data{
+		{
+			storage: models.NewBoard(models.Size{Width: 1, Height: 1}).
+				ApplyMove(models.Move{Color: models.White}),
+			wantReport: Report{WinRate: 1, Outcome: WinOutcome, IsGameOver: true},
+			wantErr:    models.ErrAlreadyWin,
+		},
+		{
+			storage: models.NewBoard(models.Size{Width: 1, Height: 1}).
+				ApplyMove(models.Move{Color: models.Black}),
+			wantReport: Report{WinRate: 0, Outcome: LossOutcome, IsGameOver: true},
+			wantErr:    models.ErrAlreadyLoss,
+		},
+	} {
+		searcher := ResigningSearcher{
+			Searcher: MoveSearcher{MoveGenerator: models.MoveGenerator{}},
+		}
+		root := &tree.Node{
+			Move:    models.NewPreliminaryMove(models.Black),
+			Storage: data.storage,
+		}
+		gotReport, gotErr := searcher.Search(root)
+
+		if !reflect.DeepEqual(gotReport, data.wantReport) {
+			test.Fail()
+		}
+		if gotErr != nil {
+			test.Fail()
+		}
+
+		gotNode, gotErr := searcher.SearchMove(root)
+
+		if gotNode != nil {
+			test.Fail()
+		}
+		if gotErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// +build long
+
+package searchers_test
+
+import (
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/diagrams"
+	"github.com/thewizardplusplus/go-atari-montecarlo/solvers"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestSearch_withSolverOracle(test *testing.T) {
+	solver := solvers.NegamaxSolver{MoveGenerator: models.MoveGenerator{}}
+	for _, diagram := range []string{
+		`
+			. . .
+			W . B
+			. . .
+		`,
+		`
+			. B .
+			W . B
+			. . B
+		`,
+	} {
+		storage, previousMove, err := diagrams.Parse(diagram)
+		if err != nil {
+			test.Fail()
+			continue
+		}
+
+		// the solver is used as an oracle: black has a proven win
+		root := &tree.Node{Move: previousMove, Storage: storage}
+		solution, err := solver.Solve(root)
+		if err != nil || solution.Result != solvers.Win {
+			test.Fail()
+			continue
+		}
+
+		for _, settings := range []searchSettings{
+			{},
+			{
+				parallelSimulator: true,
+			},
+		} {
+			settings.maximalPass = 1000
+
+			move, err := search(storage, models.Black, settings)
+			if err != nil {
+				test.Fail()
+				continue
+			}
+
+			// after a winning move, white should have a proven loss
+			child := &tree.Node{Move: move, Storage: storage.ApplyMove(move)}
+			childSolution, err := solver.Solve(child)
+			if err == nil && childSolution.Result != solvers.Loss {
+				test.Fail()
+			}
+		}
+	}
+}
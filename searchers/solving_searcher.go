@@ -0,0 +1,51 @@
+package searchers
+
+import (
+	"github.com/thewizardplusplus/go-atari-montecarlo/solvers"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// SolvingSearcher ...
+//
+// For positions with a count of legal moves not greater than the maximal one,
+// it tries to prove a win of the player to move by the proof-number search
+// first. If the win is proven, the first move of the main line is selected;
+// otherwise, the move is searched by the searcher.
+//
+type SolvingSearcher struct {
+	Searcher         MoveSearcher
+	Solver           solvers.ProofNumberSolver
+	MaximalMoveCount int
+}
+
+// SearchMove ...
+//
+// If the root has a child with the proven move, the child is returned;
+// otherwise, a new node is returned that isn't added to children of the root.
+//
+// Returned error can be models.ErrAlreadyLoss, models.ErrAlreadyWin,
+// ErrFailedBuilding or an error of the move generator of the solver.
+//
+func (searcher SolvingSearcher) SearchMove(
+	root *tree.Node,
+) (*tree.Node, error) {
+	generator := searcher.Searcher.MoveGenerator
+	moves, err := generator.LegalMoves(root.Storage, root.Move)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(moves) <= searcher.MaximalMoveCount {
+		attacker := root.Move.Color.Negative()
+		solution, err := searcher.Solver.Prove(root, attacker)
+		if err != nil {
+			return nil, err
+		}
+
+		if solution.Proof == solvers.Proven {
+			return root.FindOrNewChild(solution.MainLine[0]), nil
+		}
+	}
+
+	return searcher.Searcher.SearchMove(root)
+}
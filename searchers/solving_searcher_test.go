@@ -0,0 +1,99 @@
+package searchers
+
+import (
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/solvers"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestSolvingSearcherSearchMove(test *testing.T) {
+	type data struct {
+		maximalMoveCount int
+		root             *tree.Node
+		wantMove         models.Move
+	}
+
+	searchedMove := models.Move{Color: models.Black, Point: models.Point{}}
+	for _, data := range []data{
+		// +-+-+-+
+		// |W|W|W|
+		// +-+-+-+
+		// |W|W|W|
+		// +-+-+-+
+		// |W|W| |
+		// +-+-+-+
+		{
+			maximalMoveCount: 1,
+			root: func() *tree.Node {
+				board := models.NewBoard(models.Size{Width: 3, Height: 3})
+				points := board.Size().Points()
+				for _, point := range points[:len(points)-1] {
+					move := models.Move{Color: models.White, Point: point}
+					board = board.ApplyMove(move)
+				}
+
+				return &tree.Node{
+					Move:    models.NewPreliminaryMove(models.Black),
+					Storage: board,
+				}
+			}(),
+			wantMove: models.Move{
+				Color: models.Black,
+				Point: models.Point{Column: 2, Row: 2},
+			},
+		},
+		{
+			maximalMoveCount: 1,
+			root: &tree.Node{
+				Move:    models.NewPreliminaryMove(models.Black),
+				Storage: models.NewBoard(models.Size{Width: 3, Height: 3}),
+			},
+			wantMove: searchedMove,
+		},
+		// the win of white is proven, so black moves are searched
+		{
+			maximalMoveCount: 2,
+			root: &tree.Node{
+				Move:    models.NewPreliminaryMove(models.Black),
+				Storage: models.NewBoard(models.Size{Width: 2, Height: 1}),
+			},
+			wantMove: searchedMove,
+		},
+	} {
+		searcher := SolvingSearcher{
+			Searcher: MoveSearcher{
+				MoveGenerator: models.MoveGenerator{},
+				Builder: MockBuilder{
+					pass: func(root *tree.Node) {
+						root.Children = tree.NodeGroup{
+							{Parent: root, Move: searchedMove},
+						}
+					},
+				},
+				NodeSelector: MockNodeSelector{
+					selectNode: func(nodes tree.NodeGroup) *tree.Node {
+						return nodes[0]
+					},
+				},
+			},
+			Solver: solvers.ProofNumberSolver{
+				MoveGenerator: models.MoveGenerator{},
+			},
+			MaximalMoveCount: data.maximalMoveCount,
+		}
+		node, err := searcher.SearchMove(data.root)
+
+		if !reflect.DeepEqual(node.Move, data.wantMove) {
+			test.Fail()
+		}
+		if node.Parent != data.root {
+			test.Fail()
+		}
+		if err != nil {
+			test.Fail()
+		}
+	}
+}
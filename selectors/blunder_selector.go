@@ -0,0 +1,30 @@
+package selectors
+
+import (
+	"math/rand"
+
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// BlunderSelector ...
+//
+// With the probability, it selects a uniformly random node, e.g. for weaker
+// play; otherwise, it uses the inner selector.
+//
+type BlunderSelector struct {
+	NodeSelector tree.NodeSelector
+	Probability  float64
+	// If it's nil, the global randomizer of the math/rand package is used.
+	// Otherwise, the selector isn't safe for concurrent use.
+	Randomizer *rand.Rand
+}
+
+// SelectNode ...
+func (selector BlunderSelector) SelectNode(nodes tree.NodeGroup) *tree.Node {
+	randomizer := selector.Randomizer
+	if len(nodes) != 0 && randomFloat(randomizer) < selector.Probability {
+		return nodes[randomIndex(randomizer, len(nodes))]
+	}
+
+	return selector.NodeSelector.SelectNode(nodes)
+}
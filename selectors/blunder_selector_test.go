@@ -0,0 +1,79 @@
+package selectors
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestBlunderSelectorSelectNode(test *testing.T) {
+	type data struct {
+		probability float64
+		wantIndices map[int]bool
+	}
+
+	// make the random generator deterministic for test reproducibility
+	rand.Seed(1)
+
+	for _, data := range []data{
+		{
+			probability: 0,
+			wantIndices: map[int]bool{0: true},
+		},
+		{
+			probability: 1,
+			wantIndices: map[int]bool{0: true, 1: true, 2: true},
+		},
+	} {
+		nodes := tree.NodeGroup{&tree.Node{}, &tree.Node{}, &tree.Node{}}
+		selector := BlunderSelector{
+			NodeSelector: MaximalNodeSelector{
+				NodeScorer: FirstNodeScorer{nodes: nodes},
+			},
+			Probability: data.probability,
+		}
+
+		gotIndices := make(map[int]bool)
+		for i := 0; i < 100; i++ {
+			got := selector.SelectNode(nodes)
+			for index, node := range nodes {
+				if got == node {
+					gotIndices[index] = true
+				}
+			}
+		}
+
+		if len(gotIndices) != len(data.wantIndices) {
+			test.Fail()
+		}
+		for index := range gotIndices {
+			if !data.wantIndices[index] {
+				test.Fail()
+			}
+		}
+	}
+}
+
+func TestBlunderSelectorSelectNode_withRandomizer(test *testing.T) {
+	nodes := tree.NodeGroup{&tree.Node{}, &tree.Node{}, &tree.Node{}}
+
+	var selections [2][]*tree.Node
+	for index := range selections {
+		selector := BlunderSelector{
+			NodeSelector: MaximalNodeSelector{
+				NodeScorer: FirstNodeScorer{nodes: nodes},
+			},
+			Probability: 0.5,
+			Randomizer:  rand.New(rand.NewSource(1)),
+		}
+		for i := 0; i < 20; i++ {
+			selections[index] = append(selections[index], selector.SelectNode(nodes))
+		}
+	}
+
+	if !reflect.DeepEqual(selections[0], selections[1]) {
+		test.Fail()
+	}
+}
@@ -7,10 +7,14 @@ import (
 )
 
 // RandomMoveSelector ...
-type RandomMoveSelector struct{}
+type RandomMoveSelector struct {
+	// If it's nil, the global randomizer of the math/rand package is used.
+	// Otherwise, the selector isn't safe for concurrent use.
+	Randomizer *rand.Rand
+}
 
 // SelectMove ...
 func (selector RandomMoveSelector) SelectMove(moves []models.Move) models.Move {
-	index := rand.Intn(len(moves))
+	index := randomIndex(selector.Randomizer, len(moves))
 	return moves[index]
 }
@@ -43,3 +43,25 @@ func TestRandomMoveSelectorSelectMove(test *testing.T) {
 		test.Fail()
 	}
 }
+
+func TestRandomMoveSelectorSelectMove_withRandomizer(test *testing.T) {
+	var moves []models.Move
+	for column := 0; column < 5; column++ {
+		moves = append(moves, models.Move{
+			Color: models.White,
+			Point: models.Point{Column: column},
+		})
+	}
+
+	var selections [2][]models.Move
+	for index := range selections {
+		selector := RandomMoveSelector{Randomizer: rand.New(rand.NewSource(1))}
+		for i := 0; i < 20; i++ {
+			selections[index] = append(selections[index], selector.SelectMove(moves))
+		}
+	}
+
+	if !reflect.DeepEqual(selections[0], selections[1]) {
+		test.Fail()
+	}
+}
@@ -0,0 +1,25 @@
+package selectors
+
+import (
+	"math/rand"
+)
+
+// it uses the global randomizer of the math/rand package,
+// if the passed one is nil
+func randomFloat(randomizer *rand.Rand) float64 {
+	if randomizer == nil {
+		return rand.Float64()
+	}
+
+	return randomizer.Float64()
+}
+
+// it uses the global randomizer of the math/rand package,
+// if the passed one is nil
+func randomIndex(randomizer *rand.Rand, length int) int {
+	if randomizer == nil {
+		return rand.Intn(length)
+	}
+
+	return randomizer.Intn(length)
+}
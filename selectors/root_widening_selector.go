@@ -0,0 +1,76 @@
+package selectors
+
+import (
+	"sort"
+
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// RootWideningSelector ...
+//
+// Among children of the root, it considers the best ones ranked by game
+// counts; the width limits their count. If any of them has less games than
+// the minimal share of games of the root, it selects the one with the least
+// games. Otherwise, and for other nodes, it uses the inner selector.
+//
+// The root is bound by builders.TreeBuilder (see tree.RootedNodeSelector),
+// so it can have a parent, e.g. if a subtree is reused. If it isn't bound,
+// a node without a parent is treated as the root.
+//
+// It's intended for multi-PV analysis, so all analyzed variations get
+// meaningful budgets.
+//
+type RootWideningSelector struct {
+	NodeSelector tree.NodeSelector
+	Width        int
+	MinimalShare float64
+	Root         *tree.Node
+}
+
+// WithRoot ...
+func (selector RootWideningSelector) WithRoot(
+	root *tree.Node,
+) tree.NodeSelector {
+	selector.Root = root
+	return selector
+}
+
+// SelectNode ...
+func (selector RootWideningSelector) SelectNode(
+	nodes tree.NodeGroup,
+) *tree.Node {
+	if len(nodes) == 0 || !selector.isRoot(nodes[0].Parent) {
+		return selector.NodeSelector.SelectNode(nodes)
+	}
+
+	bestNodes := append(tree.NodeGroup(nil), nodes...)
+	sort.SliceStable(bestNodes, func(i int, j int) bool {
+		return bestNodes[i].State.GameCount > bestNodes[j].State.GameCount
+	})
+	if len(bestNodes) > selector.Width {
+		bestNodes = bestNodes[:selector.Width]
+	}
+
+	rootGameCount := float64(nodes[0].Parent.State.GameCount)
+	minimalGameCount := selector.MinimalShare * rootGameCount
+	var leastNode *tree.Node
+	for _, node := range bestNodes {
+		if float64(node.State.GameCount) < minimalGameCount &&
+			(leastNode == nil || node.State.GameCount < leastNode.State.GameCount) {
+			leastNode = node
+		}
+	}
+	if leastNode != nil {
+		return leastNode
+	}
+
+	return selector.NodeSelector.SelectNode(nodes)
+}
+
+func (selector RootWideningSelector) isRoot(node *tree.Node) bool {
+	if selector.Root != nil {
+		return node == selector.Root
+	}
+
+	return node != nil && node.Parent == nil
+}
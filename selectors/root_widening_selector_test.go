@@ -0,0 +1,94 @@
+package selectors
+
+import (
+	"testing"
+
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestRootWideningSelectorSelectNode(test *testing.T) {
+	type data struct {
+		root      *tree.Node
+		isBound   bool
+		gameCount []int
+		wantIndex int
+	}
+
+	// the inner selector selects the first node
+	for _, data := range []data{
+		// the least of the best nodes has enough games
+		{
+			root:      &tree.Node{State: tree.NodeState{GameCount: 10}},
+			gameCount: []int{5, 3, 2},
+			wantIndex: 0,
+		},
+		// the least of the best nodes has too few games
+		{
+			root:      &tree.Node{State: tree.NodeState{GameCount: 10}},
+			gameCount: []int{8, 1, 1},
+			wantIndex: 1,
+		},
+		// the lacking node is out of the best nodes
+		{
+			root:      &tree.Node{State: tree.NodeState{GameCount: 10}},
+			gameCount: []int{2, 0, 4, 4},
+			wantIndex: 0,
+		},
+		// the nodes aren't children of a root
+		{
+			root: &tree.Node{
+				Parent: &tree.Node{},
+				State:  tree.NodeState{GameCount: 10},
+			},
+			gameCount: []int{8, 1, 1},
+			wantIndex: 0,
+		},
+		// the nodes are children of a bound root with a parent
+		{
+			root: &tree.Node{
+				Parent: &tree.Node{},
+				State:  tree.NodeState{GameCount: 10},
+			},
+			isBound:   true,
+			gameCount: []int{8, 1, 1},
+			wantIndex: 1,
+		},
+	} {
+		var nodes tree.NodeGroup
+		for _, gameCount := range data.gameCount {
+			nodes = append(nodes, &tree.Node{
+				Parent: data.root,
+				State:  tree.NodeState{GameCount: gameCount},
+			})
+		}
+
+		var selector tree.NodeSelector // nolint: staticcheck
+		selector = RootWideningSelector{
+			NodeSelector: MaximalNodeSelector{
+				NodeScorer: FirstNodeScorer{nodes: nodes},
+			},
+			Width:        3,
+			MinimalShare: 0.2,
+		}
+		if data.isBound {
+			selector = selector.(tree.RootedNodeSelector).WithRoot(data.root)
+		}
+		got := selector.SelectNode(nodes)
+
+		if got != nodes[data.wantIndex] {
+			test.Fail()
+		}
+	}
+}
+
+type FirstNodeScorer struct {
+	nodes tree.NodeGroup
+}
+
+func (scorer FirstNodeScorer) ScoreNode(node *tree.Node) float64 {
+	if node == scorer.nodes[0] {
+		return 1
+	}
+
+	return 0
+}
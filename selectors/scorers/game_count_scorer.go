@@ -0,0 +1,17 @@
+package scorers
+
+import (
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// GameCountScorer ...
+//
+// It scores a node by its game count, so it's suitable for selecting
+// the most visited node.
+//
+type GameCountScorer struct{}
+
+// ScoreNode ...
+func (scorer GameCountScorer) ScoreNode(node *tree.Node) float64 {
+	return gameCount(node)
+}
@@ -0,0 +1,21 @@
+package scorers
+
+import (
+	"testing"
+
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestGameCountScorerScoreNode(test *testing.T) {
+	node := &tree.Node{
+		State: tree.NodeState{
+			GameCount: 4,
+			WinCount:  3,
+		},
+	}
+	got := GameCountScorer{}.ScoreNode(node)
+
+	if got != 4 {
+		test.Fail()
+	}
+}
@@ -0,0 +1,57 @@
+package selectors
+
+import (
+	"math"
+	"math/rand"
+
+	"github.com/thewizardplusplus/go-atari-montecarlo/selectors/scorers"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// TemperatureSelector ...
+//
+// It selects a node randomly with a weight equal to its game count raised
+// to the power of one divided by the temperature, so a higher temperature
+// makes the selection more uniform. If the temperature is zero or all nodes
+// have no games, it selects the node with the maximal game count.
+//
+type TemperatureSelector struct {
+	Temperature float64
+	// If it's nil, the global randomizer of the math/rand package is used.
+	// Otherwise, the selector isn't safe for concurrent use.
+	Randomizer *rand.Rand
+}
+
+// SelectNode ...
+func (selector TemperatureSelector) SelectNode(
+	nodes tree.NodeGroup,
+) *tree.Node {
+	maximalSelector := MaximalNodeSelector{
+		NodeScorer: scorers.GameCountScorer{},
+	}
+	if selector.Temperature == 0 {
+		return maximalSelector.SelectNode(nodes)
+	}
+
+	weights := make([]float64, len(nodes))
+	var totalWeight float64
+	for index, node := range nodes {
+		gameCount := float64(node.State.GameCount)
+		weights[index] = math.Pow(gameCount, 1/selector.Temperature)
+		totalWeight += weights[index]
+	}
+	if totalWeight == 0 {
+		return maximalSelector.SelectNode(nodes)
+	}
+
+	threshold := randomFloat(selector.Randomizer) * totalWeight
+	for index, weight := range weights {
+		if threshold < weight {
+			return nodes[index]
+		}
+
+		threshold -= weight
+	}
+
+	return nodes[len(nodes)-1]
+}
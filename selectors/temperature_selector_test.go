@@ -0,0 +1,78 @@
+package selectors
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestTemperatureSelectorSelectNode(test *testing.T) {
+	type data struct {
+		temperature float64
+		gameCount   []int
+		wantIndex   int
+	}
+
+	// make the random generator deterministic for test reproducibility
+	rand.Seed(1)
+
+	for _, data := range []data{
+		// the zero temperature
+		{
+			temperature: 0,
+			gameCount:   []int{2, 5, 3},
+			wantIndex:   1,
+		},
+		// nodes without games
+		{
+			temperature: 1,
+			gameCount:   []int{0, 0, 0},
+			wantIndex:   0,
+		},
+		// the only node with games
+		{
+			temperature: 2,
+			gameCount:   []int{0, 4, 0},
+			wantIndex:   1,
+		},
+	} {
+		var nodes tree.NodeGroup
+		for _, gameCount := range data.gameCount {
+			nodes = append(nodes, &tree.Node{
+				State: tree.NodeState{GameCount: gameCount},
+			})
+		}
+
+		selector := TemperatureSelector{Temperature: data.temperature}
+		got := selector.SelectNode(nodes)
+
+		if got != nodes[data.wantIndex] {
+			test.Fail()
+		}
+	}
+}
+
+func TestTemperatureSelectorSelectNode_withRandomizer(test *testing.T) {
+	nodes := tree.NodeGroup{
+		&tree.Node{State: tree.NodeState{GameCount: 1}},
+		&tree.Node{State: tree.NodeState{GameCount: 2}},
+		&tree.Node{State: tree.NodeState{GameCount: 3}},
+	}
+
+	var selections [2][]*tree.Node
+	for index := range selections {
+		selector := TemperatureSelector{
+			Temperature: 1,
+			Randomizer:  rand.New(rand.NewSource(1)),
+		}
+		for i := 0; i < 20; i++ {
+			selections[index] = append(selections[index], selector.SelectNode(nodes))
+		}
+	}
+
+	if !reflect.DeepEqual(selections[0], selections[1]) {
+		test.Fail()
+	}
+}
@@ -0,0 +1,90 @@
+package selfplay
+
+import (
+	"math/rand"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// Searcher ...
+//
+// It's implemented by searchers.MoveSearcher.
+//
+type Searcher interface {
+	SearchMove(root *tree.Node) (*tree.Node, error)
+}
+
+// Game ...
+type Game struct {
+	MoveGenerator models.Generator
+	Size          models.Size
+	// The first moves of the game are random.
+	OpeningMoveCount int
+}
+
+// Play ...
+//
+// The black player moves first. It returns the color of the winner.
+//
+// Returned error can be an error of any searcher, except models.ErrAlreadyLoss
+// and models.ErrAlreadyWin.
+//
+func (game Game) Play(
+	black Searcher,
+	white Searcher,
+	randomizer *rand.Rand,
+) (models.Color, error) {
+	winner, _, err := game.PlayRecorded(black, white, randomizer)
+	return winner, err
+}
+
+// PlayRecorded ...
+//
+// It's the same as the method Play(), but it also returns moves of the game.
+//
+func (game Game) PlayRecorded(
+	black Searcher,
+	white Searcher,
+	randomizer *rand.Rand,
+) (winner models.Color, moves []models.Move, err error) {
+	storage := models.NewBoard(game.Size)
+	previousMove := models.NewPreliminaryMove(models.Black)
+	for moveIndex := 0; ; moveIndex++ {
+		color := previousMove.Color.Negative()
+
+		var move models.Move
+		if moveIndex < game.OpeningMoveCount {
+			var legalMoves []models.Move
+			legalMoves, err = game.MoveGenerator.LegalMoves(storage, previousMove)
+			if err == nil {
+				move = legalMoves[randomizer.Intn(len(legalMoves))]
+			}
+		} else {
+			searcher := black
+			if color == models.White {
+				searcher = white
+			}
+
+			root := &tree.Node{Move: previousMove, Storage: storage}
+			var node *tree.Node
+			node, err = searcher.SearchMove(root)
+			if err == nil {
+				move = node.Move
+			}
+		}
+
+		switch err {
+		case nil:
+		case models.ErrAlreadyLoss:
+			return color.Negative(), moves, nil
+		case models.ErrAlreadyWin:
+			return color, moves, nil
+		default:
+			return 0, nil, err
+		}
+
+		storage, previousMove = storage.ApplyMove(move), move
+		moves = append(moves, move)
+	}
+}
@@ -0,0 +1,169 @@
+package selfplay
+
+import (
+	"errors"
+	"math/rand"
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+type MockSearcher struct {
+	searchMove func(root *tree.Node) (*tree.Node, error)
+}
+
+func (searcher MockSearcher) SearchMove(root *tree.Node) (*tree.Node, error) {
+	if searcher.searchMove == nil {
+		panic("not implemented")
+	}
+
+	return searcher.searchMove(root)
+}
+
+func newFirstMoveSearcher() MockSearcher {
+	return MockSearcher{
+		searchMove: func(root *tree.Node) (*tree.Node, error) {
+			generator := models.MoveGenerator{}
+			moves, err := generator.LegalMoves(root.Storage, root.Move)
+			if err != nil {
+				return nil, err
+			}
+
+			return &tree.Node{Move: moves[0]}, nil
+		},
+	}
+}
+
+func newWinningSearcher() MockSearcher {
+	return MockSearcher{
+		searchMove: func(root *tree.Node) (*tree.Node, error) {
+			return nil, models.ErrAlreadyWin
+		},
+	}
+}
+
+func TestGamePlay(test *testing.T) {
+	type fields struct {
+		openingMoveCount int
+	}
+	type args struct {
+		black Searcher
+		white Searcher
+	}
+	type data struct {
+		fields     fields
+		args       args
+		wantWinner models.Color
+		wantErr    error
+	}
+
+	searcherErr := errors.New("searcher error")
+	for _, data := range []data{
+		// +--+--+--+
+		// |B1|W2|B3|
+		// +--+--+--+
+		// |W4|  |  |
+		// +--+--+--+
+		// |  |  |  |
+		// +--+--+--+
+		{
+			fields: fields{},
+			args: args{
+				black: newFirstMoveSearcher(),
+				white: newFirstMoveSearcher(),
+			},
+			wantWinner: models.White,
+			wantErr:    nil,
+		},
+		{
+			fields: fields{},
+			args: args{
+				black: newFirstMoveSearcher(),
+				white: newWinningSearcher(),
+			},
+			wantWinner: models.White,
+			wantErr:    nil,
+		},
+		{
+			fields: fields{},
+			args: args{
+				black: newWinningSearcher(),
+				white: newFirstMoveSearcher(),
+			},
+			wantWinner: models.Black,
+			wantErr:    nil,
+		},
+		{
+			fields: fields{
+				openingMoveCount: 1,
+			},
+			args: args{
+				black: MockSearcher{
+					searchMove: func(root *tree.Node) (*tree.Node, error) {
+						panic("not implemented")
+					},
+				},
+				white: MockSearcher{
+					searchMove: func(root *tree.Node) (*tree.Node, error) {
+						return nil, searcherErr
+					},
+				},
+			},
+			wantWinner: 0,
+			wantErr:    searcherErr,
+		},
+	} {
+		game := Game{
+			MoveGenerator:    models.MoveGenerator{},
+			Size:             models.Size{Width: 3, Height: 3},
+			OpeningMoveCount: data.fields.openingMoveCount,
+		}
+		randomizer := rand.New(rand.NewSource(1))
+		gotWinner, gotErr := game.Play(data.args.black, data.args.white, randomizer)
+
+		if gotWinner != data.wantWinner {
+			test.Fail()
+		}
+		if gotErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
+
+func TestGamePlayRecorded(test *testing.T) {
+	game := Game{
+		MoveGenerator: models.MoveGenerator{},
+		Size:          models.Size{Width: 3, Height: 3},
+	}
+	randomizer := rand.New(rand.NewSource(1))
+	gotWinner, gotMoves, gotErr := game.PlayRecorded(
+		newFirstMoveSearcher(),
+		newFirstMoveSearcher(),
+		randomizer,
+	)
+
+	// +--+--+--+
+	// |B1|W2|B3|
+	// +--+--+--+
+	// |W4|  |  |
+	// +--+--+--+
+	// |  |  |  |
+	// +--+--+--+
+	wantMoves := []models.Move{
+		{Color: models.Black, Point: models.Point{Column: 0, Row: 0}},
+		{Color: models.White, Point: models.Point{Column: 1, Row: 0}},
+		{Color: models.Black, Point: models.Point{Column: 2, Row: 0}},
+		{Color: models.White, Point: models.Point{Column: 0, Row: 1}},
+	}
+	if gotWinner != models.White {
+		test.Fail()
+	}
+	if !reflect.DeepEqual(gotMoves, wantMoves) {
+		test.Fail()
+	}
+	if gotErr != nil {
+		test.Fail()
+	}
+}
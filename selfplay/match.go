@@ -0,0 +1,205 @@
+package selfplay
+
+import (
+	"math/rand"
+	"sync"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	syncutils "github.com/thewizardplusplus/go-atari-montecarlo/sync-utils"
+)
+
+// Match ...
+//
+// It plays games between two players in parallel; players alternate colors,
+// the first player is black in even games.
+//
+// If concurrency is greater than one, players passed to the method Play()
+// should be safe for concurrent use; e.g. searchers.MoveSearcher is, if its
+// terminators are stateless or are created for each search
+// by builders.FactoryBuilder.
+//
+type Match struct {
+	Game        Game
+	GameCount   int
+	Concurrency int
+	// Opening moves of the game with an index are generated by a randomizer
+	// with the seed plus the index, i.e. with the game seed. For the method
+	// PlaySeeded(), the first player of the game gets the doubled game seed
+	// and the second one gets the doubled game seed plus one.
+	Seed int64
+	// If it's nil, all games are played.
+	SPRT *SPRT
+}
+
+// Play ...
+//
+// Results of games are applied in the order of game indices, regardless
+// of the order of their finishing. If the SPRT is set, the match is stopped
+// as soon as a decision is made; games after that are ignored.
+//
+// Returned error can be an error of any player.
+//
+func (match Match) Play(first Searcher, second Searcher) (Result, error) {
+	return match.PlaySeeded(sharedSearcher{first}, sharedSearcher{second})
+}
+
+// PlaySeeded ...
+//
+// It's an equivalent of the method Play(), but players are created
+// for each game with seeds derived from the match seed, so games
+// are reproducible, if players are deterministic for a seed (see
+// configs.Config.NewSeededSearcher()).
+//
+// Returned error can be an error of any player or any factory.
+//
+func (match Match) PlaySeeded(
+	first SearcherFactory,
+	second SearcherFactory,
+) (Result, error) {
+	concurrency := match.Concurrency
+	if concurrency < 1 {
+		concurrency = 1
+	}
+
+	state := &matchState{match: match}
+	syncutils.ParallelRun(concurrency, func(index int) (result interface{}) {
+		for {
+			gameIndex, ok := state.nextGame()
+			if !ok {
+				return nil
+			}
+
+			seed := match.Seed + int64(gameIndex)
+			firstSearcher, err := first.NewSearcher(2 * seed)
+			if err != nil {
+				state.finishGame(gameIndex, 0, err)
+				continue
+			}
+
+			secondSearcher, err := second.NewSearcher(2*seed + 1)
+			if err != nil {
+				state.finishGame(gameIndex, 0, err)
+				continue
+			}
+
+			black, white := firstSearcher, secondSearcher
+			if gameIndex%2 != 0 {
+				black, white = secondSearcher, firstSearcher
+			}
+
+			randomizer := rand.New(rand.NewSource(seed))
+			winner, err := match.Game.Play(black, white, randomizer)
+			state.finishGame(gameIndex, winner, err)
+		}
+	})
+
+	return state.result, state.err
+}
+
+// SearcherFactory ...
+//
+// It creates a player for a game with the passed seed.
+//
+type SearcherFactory interface {
+	NewSearcher(seed int64) (Searcher, error)
+}
+
+// SearcherFactoryFunc ...
+//
+// It allows to use an ordinary function as a searcher factory.
+//
+type SearcherFactoryFunc func(seed int64) (Searcher, error)
+
+// NewSearcher ...
+func (factory SearcherFactoryFunc) NewSearcher(seed int64) (Searcher, error) {
+	return factory(seed)
+}
+
+// it returns the same searcher for any seed
+type sharedSearcher struct {
+	searcher Searcher
+}
+
+func (factory sharedSearcher) NewSearcher(seed int64) (Searcher, error) {
+	return factory.searcher, nil
+}
+
+type matchState struct {
+	match Match
+
+	locker          sync.Mutex
+	nextGameIndex   int
+	nextResultIndex int
+	winners         map[int]models.Color
+	result          Result
+	err             error
+}
+
+func (state *matchState) nextGame() (gameIndex int, ok bool) {
+	state.locker.Lock()
+	defer state.locker.Unlock()
+
+	if state.err != nil ||
+		state.result.Decision != Undecided ||
+		state.nextGameIndex >= state.match.GameCount {
+		return 0, false
+	}
+
+	gameIndex = state.nextGameIndex
+	state.nextGameIndex++
+
+	return gameIndex, true
+}
+
+func (state *matchState) finishGame(
+	gameIndex int,
+	winner models.Color,
+	err error,
+) {
+	state.locker.Lock()
+	defer state.locker.Unlock()
+
+	if state.err != nil || state.result.Decision != Undecided {
+		return
+	}
+	if err != nil {
+		state.err = err
+		return
+	}
+
+	// results are applied in the order of game indices,
+	// so the SPRT decision doesn't depend on the concurrency
+	if state.winners == nil {
+		state.winners = make(map[int]models.Color)
+	}
+	state.winners[gameIndex] = winner
+	for state.result.Decision == Undecided {
+		winner, ok := state.winners[state.nextResultIndex]
+		if !ok {
+			break
+		}
+
+		delete(state.winners, state.nextResultIndex)
+		state.applyResult(state.nextResultIndex, winner)
+		state.nextResultIndex++
+	}
+}
+
+func (state *matchState) applyResult(gameIndex int, winner models.Color) {
+	firstColor := models.Black
+	if gameIndex%2 != 0 {
+		firstColor = models.White
+	}
+	if winner == firstColor {
+		state.result.WinCount++
+	} else {
+		state.result.LossCount++
+	}
+
+	if state.match.SPRT != nil {
+		state.result.Decision = state.match.SPRT.Decide(
+			state.result.WinCount,
+			state.result.LossCount,
+		)
+	}
+}
@@ -0,0 +1,163 @@
+package selfplay
+
+import (
+	"errors"
+	"reflect"
+	"sync"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+func TestMatchPlay(test *testing.T) {
+	type fields struct {
+		gameCount int
+		sprt      *SPRT
+	}
+	type args struct {
+		first  Searcher
+		second Searcher
+	}
+	type data struct {
+		fields     fields
+		args       args
+		wantResult Result
+	}
+
+	for _, data := range []data{
+		// white always wins
+		{
+			fields: fields{
+				gameCount: 10,
+			},
+			args: args{
+				first:  newFirstMoveSearcher(),
+				second: newFirstMoveSearcher(),
+			},
+			wantResult: Result{WinCount: 5, LossCount: 5},
+		},
+		{
+			fields: fields{
+				gameCount: 10,
+			},
+			args: args{
+				first:  newWinningSearcher(),
+				second: newFirstMoveSearcher(),
+			},
+			wantResult: Result{WinCount: 10},
+		},
+		{
+			fields: fields{
+				gameCount: 1000,
+				sprt:      &SPRT{Elo0: 0, Elo1: 100, Alpha: 0.05, Beta: 0.05},
+			},
+			args: args{
+				first:  newWinningSearcher(),
+				second: newFirstMoveSearcher(),
+			},
+			wantResult: Result{WinCount: 12, Decision: H1Accepted},
+		},
+	} {
+		match := Match{
+			Game: Game{
+				MoveGenerator: models.MoveGenerator{},
+				Size:          models.Size{Width: 3, Height: 3},
+			},
+			GameCount:   data.fields.gameCount,
+			Concurrency: 3,
+			SPRT:        data.fields.sprt,
+		}
+		gotResult, gotErr := match.Play(data.args.first, data.args.second)
+
+		if gotResult != data.wantResult {
+			test.Fail()
+		}
+		if gotErr != nil {
+			test.Fail()
+		}
+	}
+}
+
+func TestMatchPlaySeeded(test *testing.T) {
+	var locker sync.Mutex
+	seeds := make(map[int64]bool)
+	newFactory := func(searcher Searcher) SearcherFactory {
+		return SearcherFactoryFunc(func(seed int64) (Searcher, error) {
+			locker.Lock()
+			defer locker.Unlock()
+
+			seeds[seed] = true
+			return searcher, nil
+		})
+	}
+
+	match := Match{
+		Game: Game{
+			MoveGenerator: models.MoveGenerator{},
+			Size:          models.Size{Width: 3, Height: 3},
+		},
+		GameCount:   4,
+		Concurrency: 2,
+		Seed:        10,
+	}
+	gotResult, gotErr := match.PlaySeeded(
+		newFactory(newWinningSearcher()),
+		newFactory(newFirstMoveSearcher()),
+	)
+
+	wantSeeds := map[int64]bool{
+		20: true, 21: true, 22: true, 23: true,
+		24: true, 25: true, 26: true, 27: true,
+	}
+	if !reflect.DeepEqual(seeds, wantSeeds) {
+		test.Fail()
+	}
+	if gotResult != (Result{WinCount: 4}) {
+		test.Fail()
+	}
+	if gotErr != nil {
+		test.Fail()
+	}
+}
+
+func TestMatchPlaySeeded_withError(test *testing.T) {
+	match := Match{
+		Game: Game{
+			MoveGenerator: models.MoveGenerator{},
+			Size:          models.Size{Width: 3, Height: 3},
+		},
+		GameCount:   4,
+		Concurrency: 2,
+	}
+	factory := SearcherFactoryFunc(func(seed int64) (Searcher, error) {
+		return nil, errors.New("dummy")
+	})
+	_, gotErr := match.PlaySeeded(factory, factory)
+
+	if gotErr == nil || gotErr.Error() != "dummy" {
+		test.Fail()
+	}
+}
+
+func TestMatchStateFinishGame(test *testing.T) {
+	state := &matchState{match: Match{GameCount: 3}}
+
+	// the first player is white in odd games, so it loses the second game
+	state.finishGame(1, models.Black, nil)
+	if state.result != (Result{}) {
+		test.Fail()
+	}
+
+	state.finishGame(0, models.Black, nil)
+	if state.result != (Result{WinCount: 1, LossCount: 1}) {
+		test.Fail()
+	}
+
+	state.finishGame(2, models.White, nil)
+	if state.result != (Result{WinCount: 1, LossCount: 2}) {
+		test.Fail()
+	}
+	if len(state.winners) != 0 {
+		test.Fail()
+	}
+}
@@ -0,0 +1,62 @@
+package selfplay
+
+import (
+	"github.com/thewizardplusplus/go-atari-montecarlo/intervals"
+)
+
+// Result ...
+//
+// It's from the point of view of the first player.
+//
+type Result struct {
+	WinCount  int
+	LossCount int
+	Decision  Decision
+}
+
+// GameCount ...
+func (result Result) GameCount() int {
+	return result.WinCount + result.LossCount
+}
+
+// WinRate ...
+func (result Result) WinRate() float64 {
+	if result.GameCount() == 0 {
+		return 0.5
+	}
+
+	return float64(result.WinCount) / float64(result.GameCount())
+}
+
+// ConfidenceInterval ...
+//
+// It returns the Wilson score interval of the win rate
+// (see intervals.Wilson()).
+//
+func (result Result) ConfidenceInterval(z float64) (
+	lower float64,
+	upper float64,
+) {
+	return intervals.Wilson(result.WinCount, result.GameCount(), z)
+}
+
+// EloDifference ...
+//
+// It's infinite, if one of the players won all games.
+//
+func (result Result) EloDifference() float64 {
+	return EloDifference(result.WinRate())
+}
+
+// EloConfidenceInterval ...
+//
+// It's the confidence interval of the win rate converted to the Elo
+// difference.
+//
+func (result Result) EloConfidenceInterval(z float64) (
+	lower float64,
+	upper float64,
+) {
+	lowerWinRate, upperWinRate := result.ConfidenceInterval(z)
+	return EloDifference(lowerWinRate), EloDifference(upperWinRate)
+}
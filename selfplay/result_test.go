@@ -0,0 +1,42 @@
+package selfplay
+
+import (
+	"math"
+	"testing"
+)
+
+func TestResult(test *testing.T) {
+	result := Result{WinCount: 75, LossCount: 25}
+	if result.GameCount() != 100 {
+		test.Fail()
+	}
+	if result.WinRate() != 0.75 {
+		test.Fail()
+	}
+
+	lower, upper := result.ConfidenceInterval(1.96)
+	if math.Abs(lower-0.6569) > 1e-4 || math.Abs(upper-0.8245) > 1e-4 {
+		test.Fail()
+	}
+
+	if math.Abs(result.EloDifference()-190.8485) > 1e-4 {
+		test.Fail()
+	}
+
+	eloLower, eloUpper := result.EloConfidenceInterval(1.96)
+	if eloLower >= result.EloDifference() || eloUpper <= result.EloDifference() {
+		test.Fail()
+	}
+}
+
+func TestResult_withoutGames(test *testing.T) {
+	result := Result{}
+	if result.WinRate() != 0.5 || result.EloDifference() != 0 {
+		test.Fail()
+	}
+
+	lower, upper := result.ConfidenceInterval(1.96)
+	if lower != 0 || upper != 1 {
+		test.Fail()
+	}
+}
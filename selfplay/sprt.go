@@ -0,0 +1,75 @@
+package selfplay
+
+import (
+	"math"
+)
+
+// Decision ...
+type Decision int
+
+// ...
+const (
+	Undecided Decision = iota
+	H0Accepted
+	H1Accepted
+)
+
+// SPRT ...
+//
+// It implements the sequential probability ratio test for the hypotheses
+// H0: the Elo difference is Elo0 and H1: the Elo difference is Elo1.
+//
+// Alpha and Beta are probabilities of errors of the first and the second kind
+// correspondingly.
+//
+type SPRT struct {
+	Elo0  float64
+	Elo1  float64
+	Alpha float64
+	Beta  float64
+}
+
+// LogLikelihoodRatio ...
+func (sprt SPRT) LogLikelihoodRatio(winCount int, lossCount int) float64 {
+	scoreZero, scoreOne := ExpectedScore(sprt.Elo0), ExpectedScore(sprt.Elo1)
+	return float64(winCount)*math.Log(scoreOne/scoreZero) +
+		float64(lossCount)*math.Log((1-scoreOne)/(1-scoreZero))
+}
+
+// Bounds ...
+func (sprt SPRT) Bounds() (lower float64, upper float64) {
+	lower = math.Log(sprt.Beta / (1 - sprt.Alpha))
+	upper = math.Log((1 - sprt.Beta) / sprt.Alpha)
+	return lower, upper
+}
+
+// Decide ...
+func (sprt SPRT) Decide(winCount int, lossCount int) Decision {
+	ratio := sprt.LogLikelihoodRatio(winCount, lossCount)
+	lower, upper := sprt.Bounds()
+	switch {
+	case ratio <= lower:
+		return H0Accepted
+	case ratio >= upper:
+		return H1Accepted
+	default:
+		return Undecided
+	}
+}
+
+// ExpectedScore ...
+//
+// It converts the Elo difference to the expected score.
+//
+func ExpectedScore(eloDifference float64) float64 {
+	return 1 / (1 + math.Pow(10, -eloDifference/400))
+}
+
+// EloDifference ...
+//
+// It converts the score to the Elo difference; it's the inverse function
+// for the function ExpectedScore().
+//
+func EloDifference(score float64) float64 {
+	return -400 * math.Log10(1/score-1)
+}
@@ -0,0 +1,44 @@
+package selfplay
+
+import (
+	"math"
+	"testing"
+)
+
+func TestExpectedScore(test *testing.T) {
+	for _, data := range []struct {
+		eloDifference float64
+		want          float64
+	}{
+		{eloDifference: 0, want: 0.5},
+		{eloDifference: 400, want: 10.0 / 11},
+		{eloDifference: -400, want: 1.0 / 11},
+	} {
+		got := ExpectedScore(data.eloDifference)
+		if math.Abs(got-data.want) > 1e-9 {
+			test.Fail()
+		}
+
+		if math.Abs(EloDifference(got)-data.eloDifference) > 1e-9 {
+			test.Fail()
+		}
+	}
+}
+
+func TestSPRTDecide(test *testing.T) {
+	sprt := SPRT{Elo0: 0, Elo1: 50, Alpha: 0.05, Beta: 0.05}
+	for _, data := range []struct {
+		winCount  int
+		lossCount int
+		want      Decision
+	}{
+		{winCount: 10, lossCount: 10, want: Undecided},
+		{winCount: 100, lossCount: 40, want: H1Accepted},
+		{winCount: 40, lossCount: 100, want: H0Accepted},
+	} {
+		got := sprt.Decide(data.winCount, data.lossCount)
+		if got != data.want {
+			test.Fail()
+		}
+	}
+}
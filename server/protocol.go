@@ -0,0 +1,327 @@
+package server
+
+import (
+	"strings"
+	"time"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/analysis"
+	"github.com/thewizardplusplus/go-atari-montecarlo/configs"
+	"github.com/thewizardplusplus/go-atari-montecarlo/diagrams"
+	"github.com/thewizardplusplus/go-atari-montecarlo/filters"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// Move ...
+type Move struct {
+	Color  string `json:"color"`
+	Column int    `json:"column"`
+	Row    int    `json:"row"`
+}
+
+// Point ...
+type Point struct {
+	Column int `json:"column"`
+	Row    int `json:"row"`
+}
+
+// MoveFilter ...
+//
+// See filters.MoveFilter.
+//
+type MoveFilter struct {
+	AllowedPoints []Point `json:"allowed_points,omitempty"`
+	DeniedPoints  []Point `json:"denied_points,omitempty"`
+}
+
+// Position ...
+//
+// The position is described either by the diagram (see the function
+// diagrams.ParsePosition()) or by the size and the stones. In the latter case,
+// the side to move is required and the last move is optional.
+//
+type Position struct {
+	Diagram    string `json:"diagram,omitempty"`
+	Width      int    `json:"width,omitempty"`
+	Height     int    `json:"height,omitempty"`
+	Stones     []Move `json:"stones,omitempty"`
+	SideToMove string `json:"side_to_move,omitempty"`
+	LastMove   *Move  `json:"last_move,omitempty"`
+}
+
+// SearchRequest ...
+type SearchRequest struct {
+	Position Position `json:"position"`
+	// If it's nil, the base config of the server is used.
+	Config *configs.Config `json:"config,omitempty"`
+	// If it's set, the config of the level is used (see levels.Levels());
+	// it can't be used together with the config.
+	Level string `json:"level,omitempty"`
+	// If it's set, the response contains the corresponding count
+	// of variations (see analysis.NewVariations()).
+	VariationCount int `json:"variation_count,omitempty"`
+	// The first filter is applied to moves of the root, the second one
+	// to replies to them and so on (see filters.FilteringGenerator).
+	// If the filter of the root leaves no moves, the request fails; filters
+	// of deeper plies are best-effort, i.e. a node without allowed moves
+	// is simulated without filters.
+	MoveFilters []MoveFilter `json:"move_filters,omitempty"`
+}
+
+// MoveStatistics ...
+//
+// Its state is from the point of view of the player that makes the move.
+//
+type MoveStatistics struct {
+	Move      Move    `json:"move"`
+	GameCount int     `json:"game_count"`
+	WinCount  int     `json:"win_count"`
+	WinRate   float64 `json:"win_rate"`
+}
+
+// Variation ...
+//
+// The bounds are bounds of the 95% confidence interval of the win rate;
+// the principal variation starts with the move.
+//
+type Variation struct {
+	MoveStatistics
+	LowerBound         float64 `json:"lower_bound"`
+	UpperBound         float64 `json:"upper_bound"`
+	PrincipalVariation []Move  `json:"principal_variation"`
+}
+
+// SearchResponse ...
+//
+// Moves and variations are sorted by descending game counts.
+//
+type SearchResponse struct {
+	Move               Move             `json:"move"`
+	GameCount          int              `json:"game_count"`
+	Moves              []MoveStatistics `json:"moves"`
+	PrincipalVariation []Move           `json:"principal_variation"`
+	Variations         []Variation      `json:"variations,omitempty"`
+}
+
+// AnalysisRequest ...
+//
+// If the interval is zero, snapshots are published after each pass.
+// If the maximal move count is zero, all moves are included in snapshots.
+//
+// Snapshots are published from a single tree, so the analysis is built
+// by one builder. Therefore, a config of the request with the builder
+// concurrency greater than one is rejected with ErrUnsupportedConcurrency,
+// and the builder concurrency of the base config is ignored.
+//
+type AnalysisRequest struct {
+	SearchRequest
+	Interval         time.Duration `json:"interval"`
+	MaximalMoveCount int           `json:"maximal_move_count"`
+}
+
+// SnapshotResponse ...
+//
+// Moves are sorted by descending game counts.
+//
+type SnapshotResponse struct {
+	Pass               int              `json:"pass"`
+	GameCount          int              `json:"game_count"`
+	Moves              []MoveStatistics `json:"moves"`
+	PrincipalVariation []Move           `json:"principal_variation"`
+	IsFinal            bool             `json:"is_final"`
+}
+
+// ErrorResponse ...
+type ErrorResponse struct {
+	Error string `json:"error"`
+}
+
+var (
+	colorNames = map[models.Color]string{
+		models.Black: "black",
+		models.White: "white",
+	}
+)
+
+func (request SearchRequest) moveFilters() []filters.MoveFilter {
+	moveFilters := make([]filters.MoveFilter, 0, len(request.MoveFilters))
+	for _, moveFilter := range request.MoveFilters {
+		moveFilters = append(moveFilters, filters.MoveFilter{
+			AllowedPoints: newPoints(moveFilter.AllowedPoints),
+			DeniedPoints:  newPoints(moveFilter.DeniedPoints),
+		})
+	}
+
+	return moveFilters
+}
+
+func newPoints(points []Point) []models.Point {
+	var modelPoints []models.Point
+	for _, point := range points {
+		modelPoint := models.Point{Column: point.Column, Row: point.Row}
+		modelPoints = append(modelPoints, modelPoint)
+	}
+
+	return modelPoints
+}
+
+func newMove(move models.Move) Move {
+	return Move{
+		Color:  colorNames[move.Color],
+		Column: move.Point.Column,
+		Row:    move.Point.Row,
+	}
+}
+
+func newMoveStatistics(move models.Move, state tree.NodeState) MoveStatistics {
+	var winRate float64
+	if state.GameCount != 0 {
+		winRate = state.WinRate()
+	}
+
+	return MoveStatistics{
+		Move:      newMove(move),
+		GameCount: state.GameCount,
+		WinCount:  state.WinCount,
+		WinRate:   winRate,
+	}
+}
+
+func newSnapshotResponse(snapshot analysis.Snapshot) SnapshotResponse {
+	moves := make([]MoveStatistics, 0, len(snapshot.Moves))
+	for _, move := range snapshot.Moves {
+		moves = append(moves, newMoveStatistics(move.Move, move.State))
+	}
+
+	principalVariation := make([]Move, 0, len(snapshot.PrincipalVariation))
+	for _, move := range snapshot.PrincipalVariation {
+		principalVariation = append(principalVariation, newMove(move))
+	}
+
+	return SnapshotResponse{
+		Pass:               snapshot.Pass,
+		GameCount:          snapshot.GameCount,
+		Moves:              moves,
+		PrincipalVariation: principalVariation,
+		IsFinal:            snapshot.IsFinal,
+	}
+}
+
+func newVariations(variations []analysis.Variation) []Variation {
+	jsonVariations := make([]Variation, 0, len(variations))
+	for _, variation := range variations {
+		principalVariation :=
+			make([]Move, 0, len(variation.PrincipalVariation))
+		for _, move := range variation.PrincipalVariation {
+			principalVariation = append(principalVariation, newMove(move))
+		}
+
+		jsonVariations = append(jsonVariations, Variation{
+			MoveStatistics:     newMoveStatistics(variation.Move, variation.State),
+			LowerBound:         variation.LowerBound,
+			UpperBound:         variation.UpperBound,
+			PrincipalVariation: principalVariation,
+		})
+	}
+
+	return jsonVariations
+}
+
+func parseColor(name string) (models.Color, error) {
+	for color, colorName := range colorNames {
+		if colorName == name {
+			return color, nil
+		}
+	}
+
+	return 0, ErrInvalidPosition
+}
+
+func (data Move) move() (models.Move, error) {
+	color, err := parseColor(data.Color)
+	if err != nil {
+		return models.Move{}, err
+	}
+
+	move := models.Move{
+		Color: color,
+		Point: models.Point{Column: data.Column, Row: data.Row},
+	}
+	return move, nil
+}
+
+func (data Position) position(maximalBoardSide int) (tree.Position, error) {
+	var position tree.Position
+	if data.Diagram != "" {
+		// check the size before the diagram is parsed, because parsing
+		// allocates the board
+		if !checkDiagramSize(data.Diagram, maximalBoardSide) {
+			return tree.Position{}, ErrInvalidPosition
+		}
+
+		var err error
+		position, err = diagrams.ParsePosition(data.Diagram)
+		if err != nil {
+			return tree.Position{}, ErrInvalidPosition
+		}
+	} else {
+		size := models.Size{Width: data.Width, Height: data.Height}
+		if size.Width <= 0 || size.Width > maximalBoardSide ||
+			size.Height <= 0 || size.Height > maximalBoardSide {
+			return tree.Position{}, ErrInvalidPosition
+		}
+
+		sideToMove, err := parseColor(data.SideToMove)
+		if err != nil {
+			return tree.Position{}, err
+		}
+
+		storage := models.NewBoard(size)
+		for _, stone := range data.Stones {
+			move, err := stone.move()
+			if err != nil {
+				return tree.Position{}, err
+			}
+			if !size.HasPoint(move.Point) {
+				return tree.Position{}, ErrInvalidPosition
+			}
+			if _, ok := storage.Stone(move.Point); ok {
+				return tree.Position{}, ErrInvalidPosition
+			}
+
+			storage = storage.ApplyMove(move)
+		}
+
+		position = tree.Position{Storage: storage, SideToMove: sideToMove}
+		if data.LastMove != nil {
+			lastMove, err := data.LastMove.move()
+			if err != nil {
+				return tree.Position{}, err
+			}
+
+			position.History = []models.Move{lastMove}
+		}
+	}
+
+	return position, nil
+}
+
+// it checks counts of rows and cells in rows like diagrams.Parse() does,
+// but without allocating of the board
+func checkDiagramSize(diagram string, maximalBoardSide int) bool {
+	var rowCount int
+	for _, line := range strings.Split(diagram, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, diagrams.SideToMovePrefix) {
+			continue
+		}
+
+		rowCount++
+		if rowCount > maximalBoardSide ||
+			len(strings.Join(strings.Fields(line), "")) > maximalBoardSide {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,49 @@
+package server
+
+import (
+	"testing"
+)
+
+func TestPositionPosition_withSizeLimit(test *testing.T) {
+	type data struct {
+		position Position
+		wantErr  error
+	}
+
+	for _, data := range []data{
+		{
+			position: Position{Width: 3, Height: 3, SideToMove: "black"},
+			wantErr:  nil,
+		},
+		{
+			position: Position{Width: 4, Height: 3, SideToMove: "black"},
+			wantErr:  ErrInvalidPosition,
+		},
+		{
+			position: Position{Width: 3, Height: 1 << 30, SideToMove: "black"},
+			wantErr:  ErrInvalidPosition,
+		},
+		{
+			position: Position{Width: 0, Height: 3, SideToMove: "black"},
+			wantErr:  ErrInvalidPosition,
+		},
+		{
+			position: Position{Diagram: "to move: B\nB . .\n. . .\n. . W"},
+			wantErr:  nil,
+		},
+		{
+			position: Position{Diagram: "B . . .\n. . . ."},
+			wantErr:  ErrInvalidPosition,
+		},
+		{
+			position: Position{Diagram: ". .\n. .\n. .\n. ."},
+			wantErr:  ErrInvalidPosition,
+		},
+	} {
+		_, err := data.position.position(3)
+
+		if err != data.wantErr {
+			test.Fail()
+		}
+	}
+}
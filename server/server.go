@@ -0,0 +1,419 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"expvar"
+	"net/http"
+	"runtime"
+	"sort"
+	"time"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/analysis"
+	"github.com/thewizardplusplus/go-atari-montecarlo/boards"
+	"github.com/thewizardplusplus/go-atari-montecarlo/configs"
+	"github.com/thewizardplusplus/go-atari-montecarlo/filters"
+	"github.com/thewizardplusplus/go-atari-montecarlo/levels"
+	"github.com/thewizardplusplus/go-atari-montecarlo/metrics"
+	"github.com/thewizardplusplus/go-atari-montecarlo/observers"
+	"github.com/thewizardplusplus/go-atari-montecarlo/searchers"
+	"github.com/thewizardplusplus/go-atari-montecarlo/selectors"
+	"github.com/thewizardplusplus/go-atari-montecarlo/selectors/scorers"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// ...
+const (
+	DefaultMaximalBoardSide   = 19
+	DefaultMaximalRequestSize = 1 << 20
+	DefaultMaximalPass        = 1000000
+	DefaultMaximalGameCount   = 1000000
+
+	ConfigLabel      = "config"
+	BaseConfigName   = "base"
+	CustomConfigName = "custom"
+)
+
+// ...
+var (
+	ErrInvalidPosition        = errors.New("invalid position")
+	ErrBusyServer             = errors.New("busy server")
+	ErrInvalidLevel           = errors.New("invalid level")
+	ErrUnsupportedConcurrency = errors.New("unsupported builder concurrency")
+)
+
+// Settings ...
+//
+// The timeout limits waiting for a free search slot and the search duration
+// in total; budgets of configs are reduced to it. The analysis timeout limits
+// streaming analyses the same way; if it's zero, the timeout is used. Searches
+// are also stopped, when clients close connections.
+//
+// Concurrencies and budgets of passes and game counts of configs (including
+// the base one) are reduced to the corresponding maximums, so searches
+// are bounded even without timeouts; zero budgets of configs are replaced
+// by the maximums.
+//
+// If the metrics registry is set, searches are observed by metrics labeled
+// by names of their configs (see ConfigLabel): BaseConfigName for the base
+// config, a level name for a level and CustomConfigName for any config
+// of a client, so the count of metrics is bounded.
+//
+// The maximal board side is reduced to boards.MaximalSide.
+//
+// Zero values of the maximal board side, the maximal request size,
+// the maximal pass and the maximal game count are replaced
+// by DefaultMaximalBoardSide, DefaultMaximalRequestSize, DefaultMaximalPass
+// and DefaultMaximalGameCount correspondingly. Zero values of the maximal
+// builder and simulator concurrencies are replaced by runtime.NumCPU().
+//
+type Settings struct {
+	BaseConfig         configs.Config
+	MaximalConcurrency int
+	Timeout            time.Duration
+	AnalysisTimeout    time.Duration
+	MaximalBoardSide   int
+	MaximalRequestSize int64
+	Metrics            *metrics.Registry
+
+	MaximalBuilderConcurrency   int
+	MaximalSimulatorConcurrency int
+	MaximalPass                 int
+	MaximalGameCount            int
+}
+
+// Server ...
+//
+// It provides the following endpoints:
+//
+//   - GET /health returns the status of the server;
+//   - POST /search accepts SearchRequest and returns SearchResponse;
+//   - POST /analyze accepts AnalysisRequest and streams server-sent events:
+//     "snapshot" events with SnapshotResponse during the search and
+//     the "result" event with SearchResponse at the end; the analysis
+//     is stopped, when the client closes the connection;
+//   - GET /metrics returns metrics in the Prometheus text format
+//     (if the metrics registry is set);
+//   - GET /debug/vars returns variables of the expvar package
+//     (if the metrics registry is set).
+//
+// If the game is over or move filters leave no moves, the status 422 is
+// returned.
+//
+// Errors are returned as ErrorResponse; errors of analyses are returned
+// before streaming only.
+//
+type Server struct {
+	settings  Settings
+	semaphore chan struct{}
+	mux       *http.ServeMux
+}
+
+// NewServer ...
+func NewServer(settings Settings) *Server {
+	if settings.MaximalConcurrency < 1 {
+		settings.MaximalConcurrency = 1
+	}
+	if settings.AnalysisTimeout == 0 {
+		settings.AnalysisTimeout = settings.Timeout
+	}
+	if settings.MaximalBoardSide == 0 {
+		settings.MaximalBoardSide = DefaultMaximalBoardSide
+	}
+	if settings.MaximalBoardSide > boards.MaximalSide {
+		settings.MaximalBoardSide = boards.MaximalSide
+	}
+	if settings.MaximalRequestSize == 0 {
+		settings.MaximalRequestSize = DefaultMaximalRequestSize
+	}
+	if settings.MaximalBuilderConcurrency == 0 {
+		settings.MaximalBuilderConcurrency = runtime.NumCPU()
+	}
+	if settings.MaximalSimulatorConcurrency == 0 {
+		settings.MaximalSimulatorConcurrency = runtime.NumCPU()
+	}
+	if settings.MaximalPass == 0 {
+		settings.MaximalPass = DefaultMaximalPass
+	}
+	if settings.MaximalGameCount == 0 {
+		settings.MaximalGameCount = DefaultMaximalGameCount
+	}
+
+	server := &Server{
+		settings:  settings,
+		semaphore: make(chan struct{}, settings.MaximalConcurrency),
+		mux:       http.NewServeMux(),
+	}
+	server.mux.HandleFunc("/health", server.handleHealth)
+	server.mux.HandleFunc("/search", server.handleSearch)
+	server.mux.HandleFunc("/analyze", server.handleAnalyze)
+	if settings.Metrics != nil {
+		server.mux.Handle("/metrics", settings.Metrics)
+		server.mux.Handle("/debug/vars", expvar.Handler())
+	}
+
+	return server
+}
+
+// ServeHTTP ...
+func (server *Server) ServeHTTP(
+	writer http.ResponseWriter,
+	request *http.Request,
+) {
+	server.mux.ServeHTTP(writer, request)
+}
+
+func (server *Server) handleHealth(
+	writer http.ResponseWriter,
+	request *http.Request,
+) {
+	if request.Method != http.MethodGet {
+		writeError(writer, http.StatusMethodNotAllowed, "method not allowed")
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, map[string]string{"status": "ok"})
+}
+
+func (server *Server) handleSearch(
+	writer http.ResponseWriter,
+	request *http.Request,
+) {
+	var searchRequest SearchRequest
+	if !server.decodeRequest(writer, request, &searchRequest) {
+		return
+	}
+
+	search, ok :=
+		server.prepareSearch(writer, searchRequest, server.settings.Timeout)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := newContext(request, server.settings.Timeout)
+	defer cancel()
+
+	root := search.root
+	searcher, err := search.config.NewCancellableSearcher(
+		ctx,
+		root,
+		searchRequest.moveFilters(),
+		server.observer(search),
+	)
+	if err != nil {
+		writeError(writer, http.StatusBadRequest, err.Error())
+		return
+	}
+
+	if err := server.acquire(ctx); err != nil {
+		writeError(writer, http.StatusServiceUnavailable, err.Error())
+		return
+	}
+	defer server.release()
+
+	node, err := searcher.SearchMove(root)
+	switch {
+	case err == nil:
+	case err == models.ErrAlreadyLoss ||
+		err == models.ErrAlreadyWin ||
+		err == filters.ErrNoAllowedMoves:
+		writeError(writer, http.StatusUnprocessableEntity, err.Error())
+		return
+	case err == searchers.ErrFailedBuilding && ctx.Err() != nil:
+		// the timeout expired or the request was cancelled before any children
+		// were built
+		writeError(writer, http.StatusServiceUnavailable, ErrBusyServer.Error())
+		return
+	default:
+		writeError(writer, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	response := newSearchResponse(root, node)
+	if searchRequest.VariationCount > 0 {
+		response.Variations = newVariations(analysis.NewVariations(
+			root,
+			searchRequest.VariationCount,
+			analysis.DefaultConfidenceScore,
+		))
+	}
+
+	writeJSON(writer, http.StatusOK, response)
+}
+
+// it writes an error and returns false on failure
+func (server *Server) decodeRequest(
+	writer http.ResponseWriter,
+	request *http.Request,
+	data interface{},
+) bool {
+	if request.Method != http.MethodPost {
+		writeError(writer, http.StatusMethodNotAllowed, "method not allowed")
+		return false
+	}
+
+	maximalSize := server.settings.MaximalRequestSize
+	body := http.MaxBytesReader(writer, request.Body, maximalSize)
+	if err := json.NewDecoder(body).Decode(data); err != nil {
+		writeError(writer, http.StatusBadRequest, err.Error())
+		return false
+	}
+
+	return true
+}
+
+type preparedSearch struct {
+	root   *tree.Node
+	config configs.Config
+	// it's BaseConfigName, a level name or CustomConfigName, so the count
+	// of its values is bounded
+	configName string
+}
+
+// it writes an error and returns false on failure;
+// the config is reduced to limits of the settings and the timeout
+func (server *Server) prepareSearch(
+	writer http.ResponseWriter,
+	searchRequest SearchRequest,
+	timeout time.Duration,
+) (preparedSearch, bool) {
+	position, err := searchRequest.Position.position(
+		server.settings.MaximalBoardSide,
+	)
+	if err != nil {
+		writeError(writer, http.StatusBadRequest, err.Error())
+		return preparedSearch{}, false
+	}
+
+	root, err := position.NewRoot()
+	if err != nil {
+		writeError(writer, http.StatusBadRequest, ErrInvalidPosition.Error())
+		return preparedSearch{}, false
+	}
+
+	config, configName := server.settings.BaseConfig, BaseConfigName
+	if searchRequest.Config != nil {
+		config, configName = *searchRequest.Config, CustomConfigName
+	}
+	if searchRequest.Level != "" {
+		level, ok := levels.FindLevel(searchRequest.Level)
+		if !ok || searchRequest.Config != nil {
+			writeError(writer, http.StatusBadRequest, ErrInvalidLevel.Error())
+			return preparedSearch{}, false
+		}
+
+		config, configName = level.Config, level.Name
+	}
+	settings := server.settings
+	if config.BuilderConcurrency > settings.MaximalBuilderConcurrency {
+		config.BuilderConcurrency = settings.MaximalBuilderConcurrency
+	}
+	if config.SimulatorConcurrency > settings.MaximalSimulatorConcurrency {
+		config.SimulatorConcurrency = settings.MaximalSimulatorConcurrency
+	}
+	config.MaximalPass = reduceLimit(config.MaximalPass, settings.MaximalPass)
+	config.MaximalGameCount =
+		reduceLimit(config.MaximalGameCount, settings.MaximalGameCount)
+	if timeout != 0 &&
+		(config.MaximalDuration == 0 || config.MaximalDuration > timeout) {
+		config.MaximalDuration = timeout
+	}
+	if err := config.Validate(); err != nil {
+		writeError(writer, http.StatusBadRequest, err.Error())
+		return preparedSearch{}, false
+	}
+
+	search := preparedSearch{
+		root:       root,
+		config:     config,
+		configName: configName,
+	}
+	return search, true
+}
+
+// a zero value means no limit, so it's replaced by the maximum too;
+// negative values are kept for validation
+func reduceLimit(value int, maximum int) int {
+	if value == 0 || value > maximum {
+		return maximum
+	}
+
+	return value
+}
+
+func (server *Server) observer(search preparedSearch) observers.Observer {
+	if server.settings.Metrics == nil {
+		return nil
+	}
+
+	labels := map[string]string{ConfigLabel: search.configName}
+	return server.settings.Metrics.Metrics(labels)
+}
+
+// the context should be created by the function newContext(), so the time
+// of waiting for a free search slot is counted against the timeout
+func (server *Server) acquire(ctx context.Context) error {
+	select {
+	case server.semaphore <- struct{}{}:
+		return nil
+	case <-ctx.Done():
+		return ErrBusyServer
+	}
+}
+
+func (server *Server) release() {
+	<-server.semaphore
+}
+
+// it returns a context of the request limited by the timeout
+// (if it's not zero)
+func newContext(
+	request *http.Request,
+	timeout time.Duration,
+) (context.Context, context.CancelFunc) {
+	if timeout == 0 {
+		return context.WithCancel(request.Context())
+	}
+
+	return context.WithTimeout(request.Context(), timeout)
+}
+
+func newSearchResponse(root *tree.Node, node *tree.Node) SearchResponse {
+	children := append(tree.NodeGroup(nil), root.Children...)
+	sort.SliceStable(children, func(i int, j int) bool {
+		return children[i].State.GameCount > children[j].State.GameCount
+	})
+
+	moves := make([]MoveStatistics, 0, len(children))
+	for _, child := range children {
+		moves = append(moves, newMoveStatistics(child.Move, child.State))
+	}
+
+	selector := selectors.MaximalNodeSelector{
+		NodeScorer: scorers.GameCountScorer{},
+	}
+	principalVariation := []Move{newMove(node.Move)}
+	for _, variationNode := range node.PrincipalVariation(selector) {
+		principalVariation =
+			append(principalVariation, newMove(variationNode.Move))
+	}
+
+	return SearchResponse{
+		Move:               newMove(node.Move),
+		GameCount:          root.State.GameCount,
+		Moves:              moves,
+		PrincipalVariation: principalVariation,
+	}
+}
+
+func writeJSON(writer http.ResponseWriter, status int, data interface{}) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(status)
+	json.NewEncoder(writer).Encode(data) // nolint: errcheck
+}
+
+func writeError(writer http.ResponseWriter, status int, message string) {
+	writeJSON(writer, status, ErrorResponse{Error: message})
+}
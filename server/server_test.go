@@ -0,0 +1,419 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thewizardplusplus/go-atari-montecarlo/analysis"
+	"github.com/thewizardplusplus/go-atari-montecarlo/configs"
+	"github.com/thewizardplusplus/go-atari-montecarlo/metrics"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestServerHealth(test *testing.T) {
+	type data struct {
+		method     string
+		wantStatus int
+	}
+
+	for _, data := range []data{
+		{method: http.MethodGet, wantStatus: http.StatusOK},
+		{method: http.MethodPost, wantStatus: http.StatusMethodNotAllowed},
+	} {
+		server := NewServer(Settings{})
+		request := httptest.NewRequest(data.method, "/health", nil)
+		recorder := httptest.NewRecorder()
+		server.ServeHTTP(recorder, request)
+
+		if recorder.Code != data.wantStatus {
+			test.Fail()
+		}
+	}
+}
+
+func TestServerSearch(test *testing.T) {
+	type data struct {
+		body         string
+		wantStatus   int
+		wantResponse *SearchResponse
+	}
+
+	wantResponse := &SearchResponse{
+		Move:      Move{Color: "black", Column: 2, Row: 2},
+		GameCount: 2,
+		Moves: []MoveStatistics{
+			{
+				Move:      Move{Color: "black", Column: 2, Row: 2},
+				GameCount: 1,
+				WinCount:  1,
+				WinRate:   1,
+			},
+		},
+		PrincipalVariation: []Move{{Color: "black", Column: 2, Row: 2}},
+	}
+	lowerBound, upperBound := analysis.WilsonInterval(
+		tree.NodeState{GameCount: 1, WinCount: 1},
+		analysis.DefaultConfidenceScore,
+	)
+	wantResponseWithVariations := *wantResponse
+	wantResponseWithVariations.Variations = []Variation{
+		{
+			MoveStatistics:     wantResponse.Moves[0],
+			LowerBound:         lowerBound,
+			UpperBound:         upperBound,
+			PrincipalVariation: wantResponse.PrincipalVariation,
+		},
+	}
+	for _, data := range []data{
+		{
+			body: `{
+				"position": {"diagram": "W W W\nW W W\nW W ."},
+				"config": {"ucb_factor": 1, "maximal_pass": 2}
+			}`,
+			wantStatus:   http.StatusOK,
+			wantResponse: wantResponse,
+		},
+		{
+			body: `{
+				"position": {"diagram": "W W W\nW W W\nW W ."},
+				"config": {"ucb_factor": 1, "maximal_pass": 2},
+				"variation_count": 3
+			}`,
+			wantStatus:   http.StatusOK,
+			wantResponse: &wantResponseWithVariations,
+		},
+		{
+			body: `{
+				"position": {
+					"width": 3,
+					"height": 3,
+					"stones": [
+						{"color": "white", "column": 0, "row": 0},
+						{"color": "white", "column": 1, "row": 0},
+						{"color": "white", "column": 2, "row": 0},
+						{"color": "white", "column": 0, "row": 1},
+						{"color": "white", "column": 1, "row": 1},
+						{"color": "white", "column": 2, "row": 1},
+						{"color": "white", "column": 0, "row": 2},
+						{"color": "white", "column": 1, "row": 2}
+					],
+					"side_to_move": "black",
+					"last_move": {"color": "white", "column": 1, "row": 2}
+				}
+			}`,
+			wantStatus:   http.StatusOK,
+			wantResponse: wantResponse,
+		},
+		{
+			body:       `{`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			body:       `{"position": {"diagram": "B X"}}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			body: `{
+				"position": {"width": 2, "height": 1, "side_to_move": "red"}
+			}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			body: `{
+				"position": {"width": 20, "height": 20, "side_to_move": "black"}
+			}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			body: `{
+				"position": {"diagram": "B ."},
+				"config": {"ucb_factor": -1}
+			}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			body: `{
+				"position": {"diagram": "B . ."},
+				"level": "unknown"
+			}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			body: `{
+				"position": {"diagram": "B . ."},
+				"config": {"ucb_factor": 1, "maximal_pass": 2},
+				"level": "novice"
+			}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			body:       `{"position": {"diagram": "B W\nW ."}}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+	} {
+		server := NewServer(Settings{
+			BaseConfig: configs.Config{UCBFactor: 1, MaximalPass: 2},
+			Timeout:    time.Second,
+		})
+		request := httptest.NewRequest(
+			http.MethodPost,
+			"/search",
+			strings.NewReader(data.body),
+		)
+		recorder := httptest.NewRecorder()
+		server.ServeHTTP(recorder, request)
+
+		if recorder.Code != data.wantStatus {
+			test.Fail()
+		}
+
+		if data.wantResponse != nil {
+			var response SearchResponse
+			err := json.NewDecoder(recorder.Body).Decode(&response)
+			if err != nil || !reflect.DeepEqual(&response, data.wantResponse) {
+				test.Fail()
+			}
+		} else {
+			var response ErrorResponse
+			err := json.NewDecoder(recorder.Body).Decode(&response)
+			if err != nil || response.Error == "" {
+				test.Fail()
+			}
+		}
+	}
+}
+
+func TestServerSearch_withBusyServer(test *testing.T) {
+	server := NewServer(Settings{
+		BaseConfig: configs.Config{UCBFactor: 1, MaximalPass: 2},
+		Timeout:    10 * time.Millisecond,
+	})
+	server.semaphore <- struct{}{}
+
+	request := httptest.NewRequest(
+		http.MethodPost,
+		"/search",
+		strings.NewReader(`{"position": {"diagram": "B . ."}}`),
+	)
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, request)
+
+	if recorder.Code != http.StatusServiceUnavailable {
+		test.Fail()
+	}
+}
+
+func TestServerPrepareSearch_withLimits(test *testing.T) {
+	server := NewServer(Settings{
+		Timeout:                     time.Second,
+		MaximalBuilderConcurrency:   2,
+		MaximalSimulatorConcurrency: 3,
+		MaximalPass:                 10,
+		MaximalGameCount:            20,
+	})
+	searchRequest := SearchRequest{
+		Position: Position{Diagram: "B . ."},
+		Config: &configs.Config{
+			UCBFactor:            1,
+			SimulatorConcurrency: 100,
+			BuilderConcurrency:   100,
+			MaximalGameCount:     1000000000,
+		},
+	}
+	search, ok := server.prepareSearch(
+		httptest.NewRecorder(),
+		searchRequest,
+		server.settings.Timeout,
+	)
+
+	wantConfig := configs.Config{
+		UCBFactor:            1,
+		SimulatorConcurrency: 3,
+		BuilderConcurrency:   2,
+		MaximalPass:          10,
+		MaximalGameCount:     20,
+		MaximalDuration:      time.Second,
+	}
+	if !reflect.DeepEqual(search.config, wantConfig) {
+		test.Fail()
+	}
+	if search.configName != CustomConfigName {
+		test.Fail()
+	}
+	if !ok {
+		test.Fail()
+	}
+}
+
+func TestServerSearch_withCancelledRequest(test *testing.T) {
+	server := NewServer(Settings{
+		BaseConfig: configs.Config{UCBFactor: 1, MaximalPass: 1000000},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	request := httptest.NewRequest(
+		http.MethodPost,
+		"/search",
+		strings.NewReader(`{"position": {"diagram": "B . ."}}`),
+	)
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, request.WithContext(ctx))
+
+	if recorder.Code != http.StatusServiceUnavailable {
+		test.Fail()
+	}
+}
+
+func TestServerMetrics(test *testing.T) {
+	registry := metrics.NewRegistry("test", time.Now)
+	server := NewServer(Settings{
+		BaseConfig: configs.Config{UCBFactor: 1, MaximalPass: 2},
+		Metrics:    registry,
+	})
+
+	searchRequest := httptest.NewRequest(
+		http.MethodPost,
+		"/search",
+		strings.NewReader(`{"position": {"diagram": "B . ."}}`),
+	)
+	server.ServeHTTP(httptest.NewRecorder(), searchRequest)
+
+	metricsRequest := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, metricsRequest)
+
+	if recorder.Code != http.StatusOK {
+		test.Fail()
+	}
+	if !strings.Contains(recorder.Body.String(), "test_searches_total{") ||
+		!strings.Contains(recorder.Body.String(), `config="base"`) {
+		test.Fail()
+	}
+
+	varsRequest := httptest.NewRequest(http.MethodGet, "/debug/vars", nil)
+	recorder = httptest.NewRecorder()
+	server.ServeHTTP(recorder, varsRequest)
+
+	if recorder.Code != http.StatusOK {
+		test.Fail()
+	}
+}
+
+func TestServerMetrics_withCustomConfigs(test *testing.T) {
+	registry := metrics.NewRegistry("test", time.Now)
+	server := NewServer(Settings{Metrics: registry})
+
+	for _, body := range []string{
+		`{
+			"position": {"diagram": "B . ."},
+			"config": {"ucb_factor": 1, "maximal_pass": 2}
+		}`,
+		`{
+			"position": {"diagram": "B . ."},
+			"config": {"ucb_factor": 1.5, "maximal_pass": 3}
+		}`,
+		`{"position": {"diagram": "B . ."}, "level": "novice"}`,
+	} {
+		request :=
+			httptest.NewRequest(http.MethodPost, "/search", strings.NewReader(body))
+		server.ServeHTTP(httptest.NewRecorder(), request)
+	}
+
+	metricsRequest := httptest.NewRequest(http.MethodGet, "/metrics", nil)
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, metricsRequest)
+
+	body := recorder.Body.String()
+	if !strings.Contains(body, `test_searches_total{config="custom"} 2`) ||
+		!strings.Contains(body, `test_searches_total{config="novice"} 1`) ||
+		strings.Count(body, "test_searches_total{") != 2 {
+		test.Fail()
+	}
+}
+
+func TestServerSearch_withLevel(test *testing.T) {
+	server := NewServer(Settings{})
+	request := httptest.NewRequest(
+		http.MethodPost,
+		"/search",
+		strings.NewReader(`{
+			"position": {"diagram": "W W W\nW W W\nW W ."},
+			"level": "novice"
+		}`),
+	)
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, request)
+
+	var response SearchResponse
+	err := json.NewDecoder(recorder.Body).Decode(&response)
+	if recorder.Code != http.StatusOK || err != nil {
+		test.Fail()
+	}
+	if response.Move != (Move{Color: "black", Column: 2, Row: 2}) {
+		test.Fail()
+	}
+}
+
+func TestServerSearch_withMoveFilters(test *testing.T) {
+	type data struct {
+		body       string
+		wantStatus int
+		wantMove   Move
+	}
+
+	for _, data := range []data{
+		{
+			body: `{
+				"position": {"diagram": ". . ."},
+				"move_filters": [{"allowed_points": [{"column": 2, "row": 0}]}]
+			}`,
+			wantStatus: http.StatusOK,
+			wantMove:   Move{Color: "black", Column: 2, Row: 0},
+		},
+		{
+			body: `{
+				"position": {"diagram": ". . ."},
+				"move_filters": [
+					{
+						"denied_points": [
+							{"column": 0, "row": 0},
+							{"column": 1, "row": 0},
+							{"column": 2, "row": 0}
+						]
+					}
+				]
+			}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+	} {
+		server := NewServer(Settings{
+			BaseConfig: configs.Config{UCBFactor: 1, MaximalPass: 10},
+		})
+		request := httptest.NewRequest(
+			http.MethodPost,
+			"/search",
+			strings.NewReader(data.body),
+		)
+		recorder := httptest.NewRecorder()
+		server.ServeHTTP(recorder, request)
+
+		if recorder.Code != data.wantStatus {
+			test.Fail()
+		}
+		if data.wantStatus == http.StatusOK {
+			var response SearchResponse
+			err := json.NewDecoder(recorder.Body).Decode(&response)
+			if err != nil || response.Move != data.wantMove {
+				test.Fail()
+			}
+		}
+	}
+}
@@ -0,0 +1,116 @@
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/analysis"
+	"github.com/thewizardplusplus/go-atari-montecarlo/builders"
+	"github.com/thewizardplusplus/go-atari-montecarlo/builders/terminators"
+	"github.com/thewizardplusplus/go-atari-montecarlo/filters"
+	"github.com/thewizardplusplus/go-atari-montecarlo/observers"
+)
+
+func (server *Server) handleAnalyze(
+	writer http.ResponseWriter,
+	request *http.Request,
+) {
+	var analysisRequest AnalysisRequest
+	if !server.decodeRequest(writer, request, &analysisRequest) {
+		return
+	}
+	if analysisRequest.Config != nil &&
+		analysisRequest.Config.BuilderConcurrency > 1 {
+		message := ErrUnsupportedConcurrency.Error()
+		writeError(writer, http.StatusBadRequest, message)
+		return
+	}
+
+	search, ok := server.prepareSearch(
+		writer,
+		analysisRequest.SearchRequest,
+		server.settings.AnalysisTimeout,
+	)
+	if !ok {
+		return
+	}
+
+	flusher, ok := writer.(http.Flusher)
+	if !ok {
+		writeError(writer, http.StatusInternalServerError, "streaming failed")
+		return
+	}
+
+	root, config := search.root, search.config
+	moveFilters := analysisRequest.moveFilters()
+	generator := filters.NewFilteringGenerator(
+		models.MoveGenerator{},
+		root.Storage,
+		moveFilters,
+	)
+	if _, err := generator.LegalMoves(root.Storage, root.Move); err != nil {
+		writeError(writer, http.StatusUnprocessableEntity, err.Error())
+		return
+	}
+
+	ctx, cancel := newContext(request, server.settings.AnalysisTimeout)
+	defer cancel()
+
+	if err := server.acquire(ctx); err != nil {
+		writeError(writer, http.StatusServiceUnavailable, err.Error())
+		return
+	}
+	defer server.release()
+
+	writer.Header().Set("Content-Type", "text/event-stream")
+	writer.Header().Set("Cache-Control", "no-cache")
+	writer.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	observer := observers.OrNop(server.observer(search))
+	publisher := analysis.PublisherFunc(func(snapshot analysis.Snapshot) {
+		writeEvent(writer, "snapshot", newSnapshotResponse(snapshot))
+		flusher.Flush()
+	})
+	publishingBuilder := analysis.NewPublishingBuilder(
+		config.NewFilteredPassBuilder(root, moveFilters, observer),
+		time.Now,
+		analysisRequest.Interval,
+		analysisRequest.MaximalMoveCount,
+		publisher,
+	)
+	builder := builders.IterativeBuilder{
+		Builder: publishingBuilder,
+		Terminator: terminators.NewGroupTerminator(
+			config.NewTerminator(root),
+			terminators.NewContextTerminator(ctx),
+		),
+		Observer: observer,
+	}
+	observer.OnSearchStart(root)
+	builder.Pass(root)
+	observer.OnSearchEnd(root)
+	publishingBuilder.PublishFinal(root)
+
+	if len(root.Children) != 0 {
+		node := config.NewMoveSelector().SelectNode(root.Children)
+		writeEvent(writer, "result", newSearchResponse(root, node))
+	} else {
+		// the analysis was stopped before any children were built
+		response := ErrorResponse{Error: "failed building"}
+		writeEvent(writer, "error", response)
+	}
+	flusher.Flush()
+}
+
+func writeEvent(writer http.ResponseWriter, name string, data interface{}) {
+	encodedData, err := json.Marshal(data)
+	if err != nil {
+		return
+	}
+
+	fmt.Fprintf(writer, "event: %s\ndata: %s\n\n", name, encodedData)
+}
@@ -0,0 +1,159 @@
+package server
+
+import (
+	"bufio"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thewizardplusplus/go-atari-montecarlo/configs"
+)
+
+type event struct {
+	name string
+	data string
+}
+
+func readEvents(reader io.Reader) ([]event, error) {
+	var events []event
+	var currentEvent event
+	scanner := bufio.NewScanner(reader)
+	for scanner.Scan() {
+		line := scanner.Text()
+		switch {
+		case line == "":
+			events = append(events, currentEvent)
+			currentEvent = event{}
+		case strings.HasPrefix(line, "event: "):
+			currentEvent.name = strings.TrimPrefix(line, "event: ")
+		case strings.HasPrefix(line, "data: "):
+			currentEvent.data = strings.TrimPrefix(line, "data: ")
+		}
+	}
+
+	return events, scanner.Err()
+}
+
+func TestServerAnalyze(test *testing.T) {
+	type data struct {
+		body       string
+		wantStatus int
+	}
+
+	for _, data := range []data{
+		{
+			body:       `{"position": {"diagram": "B . ."}}`,
+			wantStatus: http.StatusOK,
+		},
+		{
+			body:       `{"position": {"diagram": "B X"}}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			body: `{
+				"position": {"diagram": "B . ."},
+				"config": {"ucb_factor": 1, "maximal_pass": 2, "builder_concurrency": 2}
+			}`,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			body:       `{"position": {"diagram": "B W\nW ."}}`,
+			wantStatus: http.StatusUnprocessableEntity,
+		},
+	} {
+		server := NewServer(Settings{
+			BaseConfig: configs.Config{UCBFactor: 1, MaximalPass: 10},
+			Timeout:    time.Second,
+		})
+		request := httptest.NewRequest(
+			http.MethodPost,
+			"/analyze",
+			strings.NewReader(data.body),
+		)
+		recorder := httptest.NewRecorder()
+		server.ServeHTTP(recorder, request)
+
+		if recorder.Code != data.wantStatus {
+			test.Fail()
+		}
+		if data.wantStatus != http.StatusOK {
+			var response ErrorResponse
+			err := json.NewDecoder(recorder.Body).Decode(&response)
+			if err != nil || response.Error == "" {
+				test.Fail()
+			}
+
+			continue
+		}
+
+		events, err := readEvents(recorder.Body)
+		if err != nil || len(events) != 12 {
+			test.Fail()
+			continue
+		}
+
+		// the final snapshot repeats the last pass
+		for index, event := range events[:11] {
+			wantPass := index + 1
+			if index == 10 {
+				wantPass = 10
+			}
+
+			var response SnapshotResponse
+			err := json.Unmarshal([]byte(event.data), &response)
+			if err != nil ||
+				event.name != "snapshot" ||
+				response.Pass != wantPass ||
+				response.IsFinal != (index == 10) {
+				test.Fail()
+			}
+		}
+
+		var response SearchResponse
+		err = json.Unmarshal([]byte(events[11].data), &response)
+		if err != nil ||
+			events[11].name != "result" ||
+			response.GameCount != 10 ||
+			len(response.Moves) != 2 ||
+			response.Move.Color != "black" {
+			test.Fail()
+		}
+	}
+}
+
+func TestServerAnalyze_withStopping(test *testing.T) {
+	server := NewServer(Settings{
+		BaseConfig: configs.Config{UCBFactor: 1, MaximalDuration: time.Minute},
+	})
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	response, err := http.Post(
+		httpServer.URL+"/analyze",
+		"application/json",
+		strings.NewReader(`{
+			"position": {"diagram": "B . . .\n. . . .\n. . . ."},
+			"interval": 1000000
+		}`),
+	)
+	if err != nil {
+		test.Fatal(err)
+	}
+
+	reader := bufio.NewReader(response.Body)
+	if _, err := reader.ReadString('\n'); err != nil {
+		test.Fail()
+	}
+	response.Body.Close() // nolint: errcheck
+
+	// the analysis should be stopped and the search slot should be released
+	select {
+	case server.semaphore <- struct{}{}:
+	case <-time.After(10 * time.Second):
+		test.Fail()
+	}
+}
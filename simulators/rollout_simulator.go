@@ -34,3 +34,21 @@ func (simulator RolloutSimulator) Simulate(root *tree.Node) tree.NodeState {
 		storage, previousMove = storage.ApplyMove(move), move
 	}
 }
+
+// SimulatePosition ...
+//
+// It simulates a game from the position with an explicit side to move.
+// The result is from the point of view of the side to move.
+//
+// Returned error can be tree.ErrInconsistentHistory only.
+//
+func (simulator RolloutSimulator) SimulatePosition(
+	position tree.Position,
+) (tree.NodeState, error) {
+	root, err := position.NewRoot()
+	if err != nil {
+		return tree.NodeState{}, err
+	}
+
+	return simulator.Simulate(root), nil
+}
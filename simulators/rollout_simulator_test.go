@@ -375,3 +375,67 @@ func TestRolloutSimulatorSimulate(test *testing.T) {
 		}
 	}
 }
+
+func TestRolloutSimulatorSimulatePosition(test *testing.T) {
+	// +-+-+-+
+	// |W|W|W|
+	// +-+-+-+
+	// |W|W|W|
+	// +-+-+-+
+	// |W|W| |
+	// +-+-+-+
+	board := models.NewBoard(models.Size{Width: 3, Height: 3})
+	points := board.Size().Points()
+	for _, point := range points[:len(points)-1] {
+		board = board.ApplyMove(models.Move{Color: models.White, Point: point})
+	}
+
+	type data struct {
+		sideToMove models.Color
+		history    []models.Move
+		wantState  tree.NodeState
+		wantErr    error
+	}
+
+	for _, data := range []data{
+		{
+			sideToMove: models.Black,
+			wantState:  tree.NodeState{GameCount: 1, WinCount: 1},
+			wantErr:    nil,
+		},
+		{
+			sideToMove: models.White,
+			wantState:  tree.NodeState{GameCount: 1, WinCount: 0},
+			wantErr:    nil,
+		},
+		{
+			sideToMove: models.White,
+			history: []models.Move{
+				{Color: models.White, Point: points[0]},
+			},
+			wantState: tree.NodeState{},
+			wantErr:   tree.ErrInconsistentHistory,
+		},
+	} {
+		simulator := RolloutSimulator{
+			MoveGenerator: models.MoveGenerator{},
+			MoveSelector: MockMoveSelector{
+				selectMove: func(moves []models.Move) models.Move {
+					return moves[0]
+				},
+			},
+		}
+		state, err := simulator.SimulatePosition(tree.Position{
+			Storage:    board,
+			SideToMove: data.sideToMove,
+			History:    data.history,
+		})
+
+		if !reflect.DeepEqual(state, data.wantState) {
+			test.Fail()
+		}
+		if err != data.wantErr {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,298 @@
+package solvers
+
+import (
+	"sort"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/book"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// Result ...
+type Result int
+
+// ...
+const (
+	Unknown Result = iota
+	Win
+	Loss
+)
+
+// Solution ...
+//
+// Its result is from the point of view of the player that makes the move.
+//
+type Solution struct {
+	Result Result
+	Move   models.Move
+	// It's the depth of the last completed iteration.
+	Depth     int
+	NodeCount int
+}
+
+// NegamaxSolver ...
+//
+// It searches a game tree by the negamax algorithm with alpha-beta pruning,
+// iterative deepening and a transposition table. Positions beyond the depth
+// limit are evaluated as unknown; iterations are continued until the result
+// is proven or a limit is reached.
+//
+// Moves are ordered by the best move from the transposition table first
+// and then by descending game counts of corresponding nodes of the root
+// (e.g. built by the MCTS).
+//
+// Zero limits are ignored.
+//
+type NegamaxSolver struct {
+	MoveGenerator    models.Generator
+	MaximalDepth     int
+	MaximalNodeCount int
+}
+
+// Solve ...
+//
+// If the node limit is reached, the solution of the last completed iteration
+// is returned; if there isn't any, its move is the first legal one.
+//
+// Returned error can be models.ErrAlreadyLoss or models.ErrAlreadyWin,
+// or an error of the move generator.
+//
+func (solver NegamaxSolver) Solve(root *tree.Node) (Solution, error) {
+	moves, err := solver.MoveGenerator.LegalMoves(root.Storage, root.Move)
+	if err != nil {
+		return Solution{}, err
+	}
+
+	state := &solverState{
+		solver: solver,
+		table:  make(map[uint64]tableEntry),
+	}
+	solution := Solution{Move: moves[0]}
+	maximalDepth := emptyPointCount(root.Storage)
+	if solver.MaximalDepth != 0 && solver.MaximalDepth < maximalDepth {
+		maximalDepth = solver.MaximalDepth
+	}
+	for depth := 1; depth <= maximalDepth; depth++ {
+		value, move := state.negamax(
+			root.Storage,
+			root.Move,
+			root,
+			depth,
+			lossValue,
+			winValue,
+		)
+		if state.err != nil {
+			return Solution{}, state.err
+		}
+		if state.isAborted {
+			break
+		}
+
+		solution.Move, solution.Depth = move, depth
+		if value != unknownValue {
+			solution.Result = Win
+			if value == lossValue {
+				solution.Result = Loss
+			}
+
+			break
+		}
+	}
+
+	solution.NodeCount = state.nodeCount
+	return solution, nil
+}
+
+// SearchMove ...
+//
+// It allows to use the solver instead of searchers.MoveSearcher. If the root
+// has a child with the solution move, the child is returned; otherwise,
+// a new node is returned that isn't added to children of the root.
+//
+// Returned error can be models.ErrAlreadyLoss or models.ErrAlreadyWin,
+// or an error of the move generator.
+//
+func (solver NegamaxSolver) SearchMove(root *tree.Node) (*tree.Node, error) {
+	solution, err := solver.Solve(root)
+	if err != nil {
+		return nil, err
+	}
+
+	return root.FindOrNewChild(solution.Move), nil
+}
+
+const (
+	lossValue    = -1
+	unknownValue = 0
+	winValue     = 1
+)
+
+type bound int
+
+const (
+	exactBound bound = iota
+	lowerBound
+	upperBound
+)
+
+type tableEntry struct {
+	depth int
+	value int
+	bound bound
+	move  models.Move
+}
+
+func (entry tableEntry) isProven() bool {
+	return entry.value == winValue && entry.bound != upperBound ||
+		entry.value == lossValue && entry.bound != lowerBound
+}
+
+type solverState struct {
+	solver    NegamaxSolver
+	table     map[uint64]tableEntry
+	nodeCount int
+	isAborted bool
+	err       error
+}
+
+// node is a corresponding node of the root; it can be nil
+func (state *solverState) negamax(
+	storage models.StoneStorage,
+	previousMove models.Move,
+	node *tree.Node,
+	depth int,
+	alpha int,
+	beta int,
+) (value int, move models.Move) {
+	state.nodeCount++
+	maximalNodeCount := state.solver.MaximalNodeCount
+	if maximalNodeCount != 0 && state.nodeCount > maximalNodeCount {
+		state.isAborted = true
+		return unknownValue, models.Move{}
+	}
+
+	moves, err := state.solver.MoveGenerator.LegalMoves(storage, previousMove)
+	switch err {
+	case nil:
+	case models.ErrAlreadyLoss:
+		return lossValue, models.Move{}
+	case models.ErrAlreadyWin:
+		return winValue, models.Move{}
+	default:
+		state.err, state.isAborted = err, true
+		return unknownValue, models.Move{}
+	}
+	if depth == 0 {
+		return unknownValue, models.Move{}
+	}
+
+	hash := book.Hash(storage, previousMove.Color.Negative())
+	entry, hasEntry := state.table[hash]
+	if hasEntry {
+		if entry.isProven() {
+			return entry.value, entry.move
+		}
+
+		if entry.depth >= depth {
+			switch entry.bound {
+			case exactBound:
+				return entry.value, entry.move
+			case lowerBound:
+				alpha = maximum(alpha, entry.value)
+			case upperBound:
+				beta = minimum(beta, entry.value)
+			}
+			if alpha >= beta {
+				return entry.value, entry.move
+			}
+		}
+
+		orderMoves(moves, node, &entry.move)
+	} else {
+		orderMoves(moves, node, nil)
+	}
+
+	originalAlpha := alpha
+	value = lossValue - 1
+	for _, childMove := range moves {
+		childValue, _ := state.negamax(
+			storage.ApplyMove(childMove),
+			childMove,
+			node.FindChild(childMove),
+			depth-1,
+			-beta,
+			-alpha,
+		)
+		if state.isAborted {
+			return unknownValue, models.Move{}
+		}
+
+		if -childValue > value {
+			value, move = -childValue, childMove
+		}
+		alpha = maximum(alpha, value)
+		if alpha >= beta {
+			break
+		}
+	}
+
+	entry = tableEntry{depth: depth, value: value, move: move}
+	switch {
+	case value <= originalAlpha:
+		entry.bound = upperBound
+	case value >= beta:
+		entry.bound = lowerBound
+	}
+	state.table[hash] = entry
+
+	return value, move
+}
+
+func orderMoves(moves []models.Move, node *tree.Node, bestMove *models.Move) {
+	gameCounts := make(map[models.Point]int)
+	if node != nil {
+		for _, child := range node.Children {
+			gameCounts[child.Move.Point] = child.State.GameCount
+		}
+	}
+
+	sort.SliceStable(moves, func(i int, j int) bool {
+		if bestMove != nil && moves[i] != moves[j] {
+			if moves[i] == *bestMove {
+				return true
+			}
+			if moves[j] == *bestMove {
+				return false
+			}
+		}
+
+		return gameCounts[moves[i].Point] > gameCounts[moves[j].Point]
+	})
+}
+
+func emptyPointCount(storage models.StoneStorage) int {
+	var count int
+	for _, point := range storage.Size().Points() {
+		if _, ok := storage.Stone(point); !ok {
+			count++
+		}
+	}
+
+	return count
+}
+
+func maximum(a int, b int) int {
+	if a > b {
+		return a
+	}
+
+	return b
+}
+
+func minimum(a int, b int) int {
+	if a < b {
+		return a
+	}
+
+	return b
+}
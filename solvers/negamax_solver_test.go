@@ -0,0 +1,217 @@
+package solvers
+
+import (
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/diagrams"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestNegamaxSolverSolve(test *testing.T) {
+	type fields struct {
+		maximalDepth     int
+		maximalNodeCount int
+	}
+	type data struct {
+		fields     fields
+		root       *tree.Node
+		wantResult Result
+		wantMoves  []models.Move
+		wantDepth  int
+	}
+
+	for _, data := range []data{
+		// +-+-+-+
+		// |W|W|W|
+		// +-+-+-+
+		// |W|W|W|
+		// +-+-+-+
+		// |W|W| |
+		// +-+-+-+
+		{
+			fields:     fields{},
+			root:       newAlmostFullRoot(),
+			wantResult: Win,
+			wantMoves: []models.Move{
+				{Color: models.Black, Point: models.Point{Column: 2, Row: 2}},
+			},
+			wantDepth: 1,
+		},
+		{
+			fields:     fields{},
+			root:       newCapturingRoot(),
+			wantResult: Win,
+			wantMoves: []models.Move{
+				{Color: models.Black, Point: models.Point{Column: 1, Row: 0}},
+				{Color: models.Black, Point: models.Point{Column: 0, Row: 3}},
+				{Color: models.Black, Point: models.Point{Column: 2, Row: 3}},
+			},
+			wantDepth: 1,
+		},
+		{
+			fields:     fields{maximalNodeCount: 1},
+			root:       newCapturingRoot(),
+			wantResult: Unknown,
+			wantMoves: []models.Move{
+				{Color: models.Black, Point: models.Point{Column: 0, Row: 0}},
+			},
+			wantDepth: 0,
+		},
+		// +-+-+
+		// | | |
+		// +-+-+
+		{
+			fields:     fields{},
+			root:       newNarrowRoot(),
+			wantResult: Loss,
+			wantDepth:  2,
+		},
+		{
+			fields:     fields{maximalDepth: 1},
+			root:       newNarrowRoot(),
+			wantResult: Unknown,
+			wantDepth:  1,
+		},
+	} {
+		solver := NegamaxSolver{
+			MoveGenerator:    models.MoveGenerator{},
+			MaximalDepth:     data.fields.maximalDepth,
+			MaximalNodeCount: data.fields.maximalNodeCount,
+		}
+		solution, err := solver.Solve(data.root)
+
+		if solution.Result != data.wantResult {
+			test.Fail()
+		}
+		if data.wantMoves != nil && !hasMove(data.wantMoves, solution.Move) {
+			test.Fail()
+		}
+		if solution.Depth != data.wantDepth {
+			test.Fail()
+		}
+		if solution.NodeCount == 0 {
+			test.Fail()
+		}
+		if err != nil {
+			test.Fail()
+		}
+	}
+}
+
+func TestNegamaxSolverSolve_withOrdering(test *testing.T) {
+	solver := NegamaxSolver{MoveGenerator: models.MoveGenerator{}}
+	solution, _ := solver.Solve(newCapturingRoot())
+
+	root := newCapturingRoot()
+	move := models.Move{Color: models.Black, Point: models.Point{Column: 1}}
+	root.Children = tree.NodeGroup{
+		{
+			Parent:  root,
+			Move:    move,
+			Storage: root.Storage.ApplyMove(move),
+			State:   tree.NodeState{GameCount: 10, WinCount: 10},
+		},
+	}
+	orderedSolution, _ := solver.Solve(root)
+
+	if orderedSolution.Result != Win || orderedSolution.Move != move {
+		test.Fail()
+	}
+	if orderedSolution.NodeCount > solution.NodeCount {
+		test.Fail()
+	}
+}
+
+func TestNegamaxSolverSolve_withError(test *testing.T) {
+	root := newAlmostFullRoot()
+	root.Storage = root.Storage.ApplyMove(models.Move{
+		Color: models.White,
+		Point: models.Point{Column: 2, Row: 2},
+	})
+
+	solver := NegamaxSolver{MoveGenerator: models.MoveGenerator{}}
+	solution, err := solver.Solve(root)
+
+	if !reflect.DeepEqual(solution, Solution{}) {
+		test.Fail()
+	}
+	if err != models.ErrAlreadyWin {
+		test.Fail()
+	}
+}
+
+func TestNegamaxSolverSearchMove(test *testing.T) {
+	move := models.Move{
+		Color: models.Black,
+		Point: models.Point{Column: 2, Row: 2},
+	}
+
+	root := newAlmostFullRoot()
+	solver := NegamaxSolver{MoveGenerator: models.MoveGenerator{}}
+	node, err := solver.SearchMove(root)
+
+	if node.Move != move || node.Parent != root || len(root.Children) != 0 {
+		test.Fail()
+	}
+	if err != nil {
+		test.Fail()
+	}
+
+	root = newAlmostFullRoot()
+	child := &tree.Node{
+		Parent:  root,
+		Move:    move,
+		Storage: root.Storage.ApplyMove(move),
+	}
+	root.Children = tree.NodeGroup{child}
+	node, err = solver.SearchMove(root)
+
+	if node != child {
+		test.Fail()
+	}
+	if err != nil {
+		test.Fail()
+	}
+}
+
+func newAlmostFullRoot() *tree.Node {
+	board := models.NewBoard(models.Size{Width: 3, Height: 3})
+	points := board.Size().Points()
+	for _, point := range points[:len(points)-1] {
+		board = board.ApplyMove(models.Move{Color: models.White, Point: point})
+	}
+
+	return &tree.Node{
+		Move:    models.NewPreliminaryMove(models.Black),
+		Storage: board,
+	}
+}
+
+func newCapturingRoot() *tree.Node {
+	storage, previousMove, _ := diagrams.Parse(`
+		. . .
+		B W B
+		W B W
+		. . .
+	`)
+	return &tree.Node{Move: previousMove, Storage: storage}
+}
+
+func newNarrowRoot() *tree.Node {
+	return &tree.Node{
+		Move:    models.NewPreliminaryMove(models.Black),
+		Storage: models.NewBoard(models.Size{Width: 2, Height: 1}),
+	}
+}
+
+func hasMove(moves []models.Move, move models.Move) bool {
+	for _, anotherMove := range moves {
+		if anotherMove == move {
+			return true
+		}
+	}
+
+	return false
+}
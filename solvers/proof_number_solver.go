@@ -0,0 +1,254 @@
+package solvers
+
+import (
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// Proof ...
+type Proof int
+
+// ...
+const (
+	Unproven Proof = iota
+	Proven
+	Disproven
+)
+
+// ProofSolution ...
+//
+// Its main line starts from a move of the root. If the goal is proven
+// or disproven, the main line leads to the game end by the proof tree;
+// the winner prefers short variations and the loser prefers long ones.
+// Otherwise, it's the path to the most proving node.
+//
+type ProofSolution struct {
+	Proof     Proof
+	MainLine  []models.Move
+	NodeCount int
+}
+
+// ProofNumberSolver ...
+//
+// It proves or disproves the goal "the attacker wins", i.e. the attacker
+// captures first, by the proof-number search. Nodes where the attacker
+// moves are OR nodes, other ones are AND nodes.
+//
+// Unlike NegamaxSolver, it has no transposition table, so the proof tree
+// is a tree, not a graph, and positions reached by different move orders
+// are proven separately. It keeps proof and disproof numbers exact, but
+// spends nodes of the limit on repeated positions.
+//
+// A zero node limit is ignored.
+//
+type ProofNumberSolver struct {
+	MoveGenerator    models.Generator
+	MaximalNodeCount int
+}
+
+// Prove ...
+//
+// Returned error can be models.ErrAlreadyLoss or models.ErrAlreadyWin,
+// or an error of the move generator.
+//
+func (solver ProofNumberSolver) Prove(
+	root *tree.Node,
+	attacker models.Color,
+) (ProofSolution, error) {
+	_, err := solver.MoveGenerator.LegalMoves(root.Storage, root.Move)
+	if err != nil {
+		return ProofSolution{}, err
+	}
+
+	proofRoot := newProofNode(nil, root.Move, root.Storage, attacker)
+	nodeCount := 1
+	for proofRoot.proofNumber != 0 && proofRoot.disproofNumber != 0 {
+		if solver.MaximalNodeCount != 0 &&
+			nodeCount >= solver.MaximalNodeCount {
+			break
+		}
+
+		node := proofRoot.mostProvingNode()
+		childCount, err := node.expand(solver.MoveGenerator, attacker)
+		if err != nil {
+			return ProofSolution{}, err
+		}
+
+		nodeCount += childCount
+		for ; node != nil; node = node.parent {
+			node.updateNumbers()
+		}
+	}
+
+	solution := ProofSolution{
+		MainLine:  proofRoot.mainLine(),
+		NodeCount: nodeCount,
+	}
+	switch {
+	case proofRoot.proofNumber == 0:
+		solution.Proof = Proven
+	case proofRoot.disproofNumber == 0:
+		solution.Proof = Disproven
+	}
+
+	return solution, nil
+}
+
+const infiniteNumber = int(^uint32(0) >> 1)
+
+type proofNode struct {
+	parent         *proofNode
+	move           models.Move
+	storage        models.StoneStorage
+	isOrNode       bool
+	children       []*proofNode
+	proofNumber    int
+	disproofNumber int
+	depth          int
+}
+
+func newProofNode(
+	parent *proofNode,
+	move models.Move,
+	storage models.StoneStorage,
+	attacker models.Color,
+) *proofNode {
+	return &proofNode{
+		parent:         parent,
+		move:           move,
+		storage:        storage,
+		isOrNode:       move.Color.Negative() == attacker,
+		proofNumber:    1,
+		disproofNumber: 1,
+	}
+}
+
+func (node *proofNode) mostProvingNode() *proofNode {
+	for len(node.children) != 0 {
+		node = node.mostProvingChild()
+	}
+
+	return node
+}
+
+func (node *proofNode) mostProvingChild() *proofNode {
+	var selected *proofNode
+	for _, child := range node.children {
+		if selected == nil ||
+			node.isOrNode && child.proofNumber < selected.proofNumber ||
+			!node.isOrNode && child.disproofNumber < selected.disproofNumber {
+			selected = child
+		}
+	}
+
+	return selected
+}
+
+// it returns a count of created children
+func (node *proofNode) expand(
+	generator models.Generator,
+	attacker models.Color,
+) (int, error) {
+	moves, err := generator.LegalMoves(node.storage, node.move)
+	if err != nil {
+		return 0, err
+	}
+
+	for _, move := range moves {
+		storage := node.storage.ApplyMove(move)
+		child := newProofNode(node, move, storage, attacker)
+
+		_, err := generator.LegalMoves(storage, move)
+		switch err {
+		case nil:
+		case models.ErrAlreadyLoss, models.ErrAlreadyWin:
+			// the player to move in the child loses or wins correspondingly
+			winner := move.Color
+			if err == models.ErrAlreadyWin {
+				winner = move.Color.Negative()
+			}
+
+			if winner == attacker {
+				child.proofNumber, child.disproofNumber = 0, infiniteNumber
+			} else {
+				child.proofNumber, child.disproofNumber = infiniteNumber, 0
+			}
+		default:
+			return 0, err
+		}
+
+		node.children = append(node.children, child)
+	}
+
+	return len(moves), nil
+}
+
+func (node *proofNode) updateNumbers() {
+	minimalProofNumber, minimalDisproofNumber := infiniteNumber, infiniteNumber
+	var proofNumberSum, disproofNumberSum int
+	var depth int
+	for _, child := range node.children {
+		minimalProofNumber = minimum(minimalProofNumber, child.proofNumber)
+		minimalDisproofNumber =
+			minimum(minimalDisproofNumber, child.disproofNumber)
+		proofNumberSum = saturatingSum(proofNumberSum, child.proofNumber)
+		disproofNumberSum =
+			saturatingSum(disproofNumberSum, child.disproofNumber)
+		depth = maximum(depth, child.depth+1)
+	}
+
+	if node.isOrNode {
+		node.proofNumber, node.disproofNumber =
+			minimalProofNumber, disproofNumberSum
+	} else {
+		node.proofNumber, node.disproofNumber =
+			proofNumberSum, minimalDisproofNumber
+	}
+	node.depth = depth
+}
+
+func (node *proofNode) mainLine() []models.Move {
+	var moves []models.Move
+	for len(node.children) != 0 {
+		node = node.nextMainLineNode()
+		moves = append(moves, node.move)
+	}
+
+	return moves
+}
+
+func (node *proofNode) nextMainLineNode() *proofNode {
+	isProven := node.proofNumber == 0
+	isDisproven := node.disproofNumber == 0
+	if !isProven && !isDisproven {
+		return node.mostProvingChild()
+	}
+
+	// the winner selects a solved child with the shallowest expanded subtree,
+	// the loser selects a child with the deepest one
+	isWinnerNode := node.isOrNode == isProven
+	var selected *proofNode
+	for _, child := range node.children {
+		isSolved := isProven && child.proofNumber == 0 ||
+			isDisproven && child.disproofNumber == 0
+		if !isSolved {
+			continue
+		}
+
+		if selected == nil ||
+			isWinnerNode && child.depth < selected.depth ||
+			!isWinnerNode && child.depth > selected.depth {
+			selected = child
+		}
+	}
+
+	return selected
+}
+
+func saturatingSum(a int, b int) int {
+	if a >= infiniteNumber-b {
+		return infiniteNumber
+	}
+
+	return a + b
+}
@@ -0,0 +1,159 @@
+package solvers
+
+import (
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestProofNumberSolverProve(test *testing.T) {
+	type args struct {
+		root     *tree.Node
+		attacker models.Color
+	}
+	type data struct {
+		maximalNodeCount int
+		args             args
+		wantProof        Proof
+		wantMainLine     []models.Move
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				root:     newAlmostFullRoot(),
+				attacker: models.Black,
+			},
+			wantProof: Proven,
+			wantMainLine: []models.Move{
+				{Color: models.Black, Point: models.Point{Column: 2, Row: 2}},
+			},
+		},
+		{
+			args: args{
+				root:     newAlmostFullRoot(),
+				attacker: models.White,
+			},
+			wantProof: Disproven,
+			wantMainLine: []models.Move{
+				{Color: models.Black, Point: models.Point{Column: 2, Row: 2}},
+			},
+		},
+		{
+			args: args{
+				root:     newNarrowRoot(),
+				attacker: models.White,
+			},
+			wantProof: Proven,
+			wantMainLine: []models.Move{
+				{Color: models.Black, Point: models.Point{Column: 0, Row: 0}},
+				{Color: models.White, Point: models.Point{Column: 1, Row: 0}},
+			},
+		},
+		{
+			maximalNodeCount: 1,
+			args: args{
+				root:     newNarrowRoot(),
+				attacker: models.White,
+			},
+			wantProof:    Unproven,
+			wantMainLine: nil,
+		},
+		{
+			maximalNodeCount: 3,
+			args: args{
+				root:     newNarrowRoot(),
+				attacker: models.White,
+			},
+			wantProof: Unproven,
+			wantMainLine: []models.Move{
+				{Color: models.Black, Point: models.Point{Column: 0, Row: 0}},
+			},
+		},
+	} {
+		solver := ProofNumberSolver{
+			MoveGenerator:    models.MoveGenerator{},
+			MaximalNodeCount: data.maximalNodeCount,
+		}
+		solution, err := solver.Prove(data.args.root, data.args.attacker)
+
+		if solution.Proof != data.wantProof {
+			test.Fail()
+		}
+		if !reflect.DeepEqual(solution.MainLine, data.wantMainLine) {
+			test.Fail()
+		}
+		if solution.NodeCount == 0 {
+			test.Fail()
+		}
+		if err != nil {
+			test.Fail()
+		}
+	}
+}
+
+func TestProofNumberSolverProve_withDeepGoal(test *testing.T) {
+	// +-+-+-+
+	// | | | |
+	// +-+-+-+
+	// |W| |B|
+	// +-+-+-+
+	// | | | |
+	// +-+-+-+
+	board := models.NewBoard(models.Size{Width: 3, Height: 3})
+	for _, move := range []models.Move{
+		{Color: models.White, Point: models.Point{Column: 0, Row: 1}},
+		{Color: models.Black, Point: models.Point{Column: 2, Row: 1}},
+	} {
+		board = board.ApplyMove(move)
+	}
+	root := &tree.Node{
+		Move:    models.NewPreliminaryMove(models.Black),
+		Storage: board,
+	}
+
+	negamaxSolver := NegamaxSolver{MoveGenerator: models.MoveGenerator{}}
+	negamaxSolution, _ := negamaxSolver.Solve(root)
+
+	solver := ProofNumberSolver{MoveGenerator: models.MoveGenerator{}}
+	solution, err := solver.Prove(root, models.Black)
+
+	if negamaxSolution.Result != Win || solution.Proof != Proven {
+		test.Fail()
+	}
+	if err != nil {
+		test.Fail()
+	}
+
+	// the main line should be a won game
+	storage, previousMove := root.Storage, root.Move
+	for _, move := range solution.MainLine {
+		storage, previousMove = storage.ApplyMove(move), move
+	}
+
+	generator := models.MoveGenerator{}
+	_, err = generator.LegalMoves(storage, previousMove)
+	if previousMove.Color != models.Black || err != models.ErrAlreadyLoss {
+		test.Fail()
+	}
+}
+
+func TestProofNumberSolverProve_withError(test *testing.T) {
+	root := newAlmostFullRoot()
+	root.Storage = root.Storage.ApplyMove(models.Move{
+		Color: models.White,
+		Point: models.Point{Column: 2, Row: 2},
+	})
+
+	solver := ProofNumberSolver{MoveGenerator: models.MoveGenerator{}}
+	solution, err := solver.Prove(root, models.Black)
+
+	if !reflect.DeepEqual(solution, ProofSolution{}) {
+		test.Fail()
+	}
+	if err != models.ErrAlreadyWin {
+		test.Fail()
+	}
+}
@@ -0,0 +1,41 @@
+package symmetries
+
+import (
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+// ReducingGenerator ...
+//
+// It returns only one representative of each class of moves of the inner
+// generator, that are equivalent by symmetries of the storage (see
+// the function Representatives()), so equivalent moves don't split a budget
+// of a search.
+//
+// Representatives are legal moves of the storage itself, so a found move
+// can be played as is; a move found on a transformed storage can be mapped
+// back via the method Symmetry.Inverse().
+//
+// Errors of the inner generator are returned as is.
+//
+type ReducingGenerator struct {
+	Generator models.Generator
+}
+
+// LegalMoves ...
+func (generator ReducingGenerator) LegalMoves(
+	storage models.StoneStorage,
+	previousMove models.Move,
+) ([]models.Move, error) {
+	moves, err := generator.Generator.LegalMoves(storage, previousMove)
+	if err != nil {
+		return nil, err
+	}
+
+	symmetries := Symmetries(storage)
+	if len(symmetries) == 1 {
+		// there is the identity only
+		return moves, nil
+	}
+
+	return Representatives(storage.Size(), symmetries, moves), nil
+}
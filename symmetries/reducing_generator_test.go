@@ -0,0 +1,88 @@
+package symmetries
+
+import (
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+func TestReducingGeneratorLegalMoves(test *testing.T) {
+	type args struct {
+		storage      models.StoneStorage
+		previousMove models.Move
+	}
+	type data struct {
+		args      args
+		wantMoves []models.Move
+		wantErr   error
+	}
+
+	emptyStorage := models.NewBoard(models.Size{Width: 3, Height: 3})
+	blackMove := models.Move{
+		Color: models.Black,
+		Point: models.Point{Column: 1, Row: 0},
+	}
+	for _, data := range []data{
+		// the empty board
+		{
+			args: args{
+				storage:      emptyStorage,
+				previousMove: models.NewPreliminaryMove(models.Black),
+			},
+			wantMoves: []models.Move{
+				{Color: models.Black, Point: models.Point{Column: 0, Row: 0}},
+				{Color: models.Black, Point: models.Point{Column: 1, Row: 0}},
+				{Color: models.Black, Point: models.Point{Column: 1, Row: 1}},
+			},
+			wantErr: nil,
+		},
+		// +--+--+--+
+		// |  |B |  |
+		// +--+--+--+
+		// |  |  |  |
+		// +--+--+--+
+		// |  |  |  |
+		// +--+--+--+
+		{
+			args: args{
+				storage:      emptyStorage.ApplyMove(blackMove),
+				previousMove: blackMove,
+			},
+			wantMoves: []models.Move{
+				{Color: models.White, Point: models.Point{Column: 0, Row: 0}},
+				{Color: models.White, Point: models.Point{Column: 0, Row: 1}},
+				{Color: models.White, Point: models.Point{Column: 1, Row: 1}},
+				{Color: models.White, Point: models.Point{Column: 0, Row: 2}},
+				{Color: models.White, Point: models.Point{Column: 1, Row: 2}},
+			},
+			wantErr: nil,
+		},
+		// the finished game
+		{
+			args: args{
+				storage: models.NewBoard(models.Size{Width: 1, Height: 1}).
+					ApplyMove(models.Move{
+						Color: models.White,
+						Point: models.Point{Column: 0, Row: 0},
+					}),
+				previousMove: models.NewPreliminaryMove(models.Black),
+			},
+			wantMoves: nil,
+			wantErr:   models.ErrAlreadyWin,
+		},
+	} {
+		generator := ReducingGenerator{Generator: models.MoveGenerator{}}
+		gotMoves, gotErr := generator.LegalMoves(
+			data.args.storage,
+			data.args.previousMove,
+		)
+
+		if !reflect.DeepEqual(gotMoves, data.wantMoves) {
+			test.Fail()
+		}
+		if gotErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
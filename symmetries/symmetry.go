@@ -0,0 +1,147 @@
+package symmetries
+
+import (
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+// Symmetry ...
+//
+// It describes a rotation or a reflection of a board: reflections of columns
+// and rows are applied first, then the transposition. The zero value is
+// the identity.
+//
+// The transposition is valid for square boards only.
+//
+type Symmetry struct {
+	ColumnReflection bool
+	RowReflection    bool
+	Transposition    bool
+}
+
+// Symmetries ...
+//
+// It returns all symmetries that preserve the storage, the identity is always
+// the first one. There are up to eight symmetries for square boards
+// and up to four ones for other boards.
+//
+func Symmetries(storage models.StoneStorage) []Symmetry {
+	size := storage.Size()
+	transpositions := []bool{false}
+	if size.Width == size.Height {
+		transpositions = append(transpositions, true)
+	}
+
+	var symmetries []Symmetry
+	for _, transposition := range transpositions {
+		for _, columnReflection := range []bool{false, true} {
+			for _, rowReflection := range []bool{false, true} {
+				symmetry := Symmetry{
+					ColumnReflection: columnReflection,
+					RowReflection:    rowReflection,
+					Transposition:    transposition,
+				}
+				if symmetry.Preserves(storage) {
+					symmetries = append(symmetries, symmetry)
+				}
+			}
+		}
+	}
+
+	return symmetries
+}
+
+// Inverse ...
+func (symmetry Symmetry) Inverse() Symmetry {
+	if !symmetry.Transposition {
+		// reflections are inverse to themselves
+		return symmetry
+	}
+
+	// the transposition swaps axes of the reflections
+	return Symmetry{
+		ColumnReflection: symmetry.RowReflection,
+		RowReflection:    symmetry.ColumnReflection,
+		Transposition:    true,
+	}
+}
+
+// TransformPoint ...
+func (symmetry Symmetry) TransformPoint(
+	size models.Size,
+	point models.Point,
+) models.Point {
+	if symmetry.ColumnReflection {
+		point.Column = size.Width - 1 - point.Column
+	}
+	if symmetry.RowReflection {
+		point.Row = size.Height - 1 - point.Row
+	}
+	if symmetry.Transposition {
+		point.Column, point.Row = point.Row, point.Column
+	}
+
+	return point
+}
+
+// TransformMove ...
+//
+// It keeps the color of the move.
+//
+func (symmetry Symmetry) TransformMove(
+	size models.Size,
+	move models.Move,
+) models.Move {
+	move.Point = symmetry.TransformPoint(size, move.Point)
+	return move
+}
+
+// Preserves ...
+//
+// It checks that each point of the storage has the same stone (or hasn't any)
+// as its image.
+//
+func (symmetry Symmetry) Preserves(storage models.StoneStorage) bool {
+	size := storage.Size()
+	if symmetry.Transposition && size.Width != size.Height {
+		return false
+	}
+
+	for _, point := range size.Points() {
+		color, ok := storage.Stone(point)
+		imageColor, imageOK :=
+			storage.Stone(symmetry.TransformPoint(size, point))
+		if ok != imageOK || (ok && color != imageColor) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Representatives ...
+//
+// It returns the first move of each class of moves, that are equivalent
+// by the symmetries, keeping the order of moves. The symmetries should form
+// a group, e.g. be returned by the function Symmetries().
+//
+func Representatives(
+	size models.Size,
+	symmetries []Symmetry,
+	moves []models.Move,
+) []models.Move {
+	var representatives []models.Move
+	equivalentPoints := make(map[models.Point]struct{})
+	for _, move := range moves {
+		if _, ok := equivalentPoints[move.Point]; ok {
+			continue
+		}
+
+		representatives = append(representatives, move)
+		for _, symmetry := range symmetries {
+			point := symmetry.TransformPoint(size, move.Point)
+			equivalentPoints[point] = struct{}{}
+		}
+	}
+
+	return representatives
+}
@@ -0,0 +1,148 @@
+package symmetries
+
+import (
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+func TestSymmetries(test *testing.T) {
+	type data struct {
+		storage models.StoneStorage
+		want    []Symmetry
+	}
+
+	square := models.NewBoard(models.Size{Width: 3, Height: 3})
+	for _, data := range []data{
+		// the empty square board
+		{
+			storage: square,
+			want: []Symmetry{
+				{},
+				{RowReflection: true},
+				{ColumnReflection: true},
+				{ColumnReflection: true, RowReflection: true},
+				{Transposition: true},
+				{RowReflection: true, Transposition: true},
+				{ColumnReflection: true, Transposition: true},
+				{ColumnReflection: true, RowReflection: true, Transposition: true},
+			},
+		},
+		// the empty rectangular board
+		{
+			storage: models.NewBoard(models.Size{Width: 3, Height: 2}),
+			want: []Symmetry{
+				{},
+				{RowReflection: true},
+				{ColumnReflection: true},
+				{ColumnReflection: true, RowReflection: true},
+			},
+		},
+		// +--+--+--+
+		// |  |  |  |
+		// +--+--+--+
+		// |  |B |  |
+		// +--+--+--+
+		// |  |W |  |
+		// +--+--+--+
+		{
+			storage: square.
+				ApplyMove(models.Move{
+					Color: models.Black,
+					Point: models.Point{Column: 1, Row: 1},
+				}).
+				ApplyMove(models.Move{
+					Color: models.White,
+					Point: models.Point{Column: 1, Row: 2},
+				}),
+			want: []Symmetry{{}, {ColumnReflection: true}},
+		},
+		// +--+--+--+
+		// |B |  |  |
+		// +--+--+--+
+		// |  |  |  |
+		// +--+--+--+
+		// |  |  |W |
+		// +--+--+--+
+		{
+			storage: square.
+				ApplyMove(models.Move{
+					Color: models.Black,
+					Point: models.Point{Column: 0, Row: 0},
+				}).
+				ApplyMove(models.Move{
+					Color: models.White,
+					Point: models.Point{Column: 2, Row: 2},
+				}),
+			want: []Symmetry{{}, {Transposition: true}},
+		},
+	} {
+		got := Symmetries(data.storage)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+	}
+}
+
+func TestSymmetryInverse(test *testing.T) {
+	size := models.Size{Width: 3, Height: 3}
+	point := models.Point{Column: 0, Row: 1}
+	for _, symmetry := range Symmetries(models.NewBoard(size)) {
+		image := symmetry.TransformPoint(size, point)
+		got := symmetry.Inverse().TransformPoint(size, image)
+
+		if got != point {
+			test.Fail()
+		}
+	}
+}
+
+func TestRepresentatives(test *testing.T) {
+	size := models.Size{Width: 3, Height: 3}
+	var moves []models.Move
+	for _, point := range size.Points() {
+		moves = append(moves, models.Move{Color: models.Black, Point: point})
+	}
+
+	type data struct {
+		symmetries []Symmetry
+		want       []models.Move
+	}
+
+	for _, data := range []data{
+		// the identity only
+		{
+			symmetries: []Symmetry{{}},
+			want:       moves,
+		},
+		// all symmetries of the square board
+		{
+			symmetries: Symmetries(models.NewBoard(size)),
+			want: []models.Move{
+				{Color: models.Black, Point: models.Point{Column: 0, Row: 0}},
+				{Color: models.Black, Point: models.Point{Column: 1, Row: 0}},
+				{Color: models.Black, Point: models.Point{Column: 1, Row: 1}},
+			},
+		},
+		// the reflection of columns
+		{
+			symmetries: []Symmetry{{}, {ColumnReflection: true}},
+			want: []models.Move{
+				{Color: models.Black, Point: models.Point{Column: 0, Row: 0}},
+				{Color: models.Black, Point: models.Point{Column: 1, Row: 0}},
+				{Color: models.Black, Point: models.Point{Column: 0, Row: 1}},
+				{Color: models.Black, Point: models.Point{Column: 1, Row: 1}},
+				{Color: models.Black, Point: models.Point{Column: 0, Row: 2}},
+				{Color: models.Black, Point: models.Point{Column: 1, Row: 2}},
+			},
+		},
+	} {
+		got := Representatives(size, data.symmetries, moves)
+
+		if !reflect.DeepEqual(got, data.want) {
+			test.Fail()
+		}
+	}
+}
@@ -1,6 +1,8 @@
 package tree
 
 import (
+	"sort"
+
 	models "github.com/thewizardplusplus/go-atari-models"
 )
 
@@ -9,6 +11,17 @@ type NodeSelector interface {
 	SelectNode(nodes NodeGroup) *Node
 }
 
+// RootedNodeSelector ...
+//
+// It's a node selector that treats children of the search root specially,
+// so a builder should bind it to the root of a pass, which can have a parent.
+//
+type RootedNodeSelector interface {
+	NodeSelector
+
+	WithRoot(root *Node) NodeSelector
+}
+
 // Node ...
 type Node struct {
 	Parent   *Node
@@ -29,6 +42,26 @@ func (node *Node) ShallowCopy() *Node {
 	}
 }
 
+// DeepCopy ...
+//
+// It copies the node with its state and all its descendants; the copy
+// has no parent.
+//
+func (node *Node) DeepCopy() *Node {
+	nodeCopy := &Node{
+		Move:    node.Move,
+		Storage: node.Storage,
+		State:   node.State,
+	}
+	for _, child := range node.Children {
+		childCopy := child.DeepCopy()
+		childCopy.Parent = nodeCopy
+		nodeCopy.Children = append(nodeCopy.Children, childCopy)
+	}
+
+	return nodeCopy
+}
+
 // UpdateState ...
 func (node *Node) UpdateState(state NodeState) {
 	node.State.Update(state)
@@ -64,6 +97,58 @@ func (node *Node) MergeChildren(another *Node) {
 	}
 }
 
+// Depth ...
+//
+// It returns a count of ancestors of the node up to the passed root, so
+// the depth of the root itself is zero. If the passed root isn't an ancestor
+// of the node, ancestors are counted up to the root of the whole tree.
+//
+func (node *Node) Depth(root *Node) int {
+	var depth int
+	for node != root && node.Parent != nil {
+		node = node.Parent
+		depth++
+	}
+
+	return depth
+}
+
+// FindChild ...
+//
+// It returns the child of this node with the move or nil, if there's none.
+// It also returns nil, if this node is nil.
+//
+func (node *Node) FindChild(move models.Move) *Node {
+	if node == nil {
+		return nil
+	}
+
+	for _, child := range node.Children {
+		if child.Move == move {
+			return child
+		}
+	}
+
+	return nil
+}
+
+// FindOrNewChild ...
+//
+// It returns the child of this node with the move, if any. Otherwise, it
+// returns a new node with the move, which isn't added to children of this node.
+//
+func (node *Node) FindOrNewChild(move models.Move) *Node {
+	if child := node.FindChild(move); child != nil {
+		return child
+	}
+
+	return &Node{
+		Parent:  node,
+		Move:    move,
+		Storage: node.Storage.ApplyMove(move),
+	}
+}
+
 // SelectLeaf ...
 func (node *Node) SelectLeaf(selector NodeSelector) *Node {
 	for len(node.Children) > 0 {
@@ -75,6 +160,18 @@ func (node *Node) SelectLeaf(selector NodeSelector) *Node {
 
 // ExpandLeaf ...
 func (node *Node) ExpandLeaf(generator models.Generator) NodeGroup {
+	return node.ExpandLeafInPool(generator, nil)
+}
+
+// ExpandLeafInPool ...
+//
+// It's an equivalent of the method ExpandLeaf(), but it allocates children
+// in the passed pool.
+//
+func (node *Node) ExpandLeafInPool(
+	generator models.Generator,
+	pool *NodePool,
+) NodeGroup {
 	if node.State.GameCount == 0 {
 		return NodeGroup{node}
 	}
@@ -85,6 +182,107 @@ func (node *Node) ExpandLeaf(generator models.Generator) NodeGroup {
 		return NodeGroup{node}
 	}
 
-	node.Children = NewNodeGroup(node, moves)
+	node.Children = pool.NewNodeGroup(node, moves)
 	return node.Children
 }
+
+// PrincipalVariation ...
+//
+// It selects the best child of this node, then the best child of the latter
+// and so on until a leaf. This node isn't included in the result.
+//
+func (node *Node) PrincipalVariation(selector NodeSelector) NodeGroup {
+	var nodes NodeGroup
+	for len(node.Children) > 0 {
+		node = selector.SelectNode(node.Children)
+		nodes = append(nodes, node)
+	}
+
+	return nodes
+}
+
+// NodeCount ...
+//
+// It counts this node and all its descendants.
+//
+func (node *Node) NodeCount() int {
+	count := 1
+	for _, child := range node.Children {
+		count += child.NodeCount()
+	}
+
+	return count
+}
+
+// Prune ...
+//
+// It removes children of the least visited descendants of this node until
+// the node count doesn't exceed the passed maximum. This node itself always
+// keeps its children.
+//
+// States of pruned nodes aren't lost, because they are already included
+// in states of their parents.
+//
+// It returns removed children.
+//
+func (node *Node) Prune(maximalNodeCount int) NodeGroup {
+	type candidate struct {
+		node  *Node
+		depth int
+	}
+
+	// subtree sizes of candidates are computed in one post-order walk
+	var candidates []candidate
+	subtreeSizes := make(map[*Node]int)
+	var collectCandidates func(node *Node, depth int) int
+	collectCandidates = func(node *Node, depth int) int {
+		size := 1
+		for _, child := range node.Children {
+			size += collectCandidates(child, depth+1)
+		}
+		if depth != 0 && len(node.Children) != 0 {
+			candidates = append(candidates, candidate{node, depth})
+			subtreeSizes[node] = size
+		}
+
+		return size
+	}
+
+	nodeCount := collectCandidates(node, 0)
+	if nodeCount <= maximalNodeCount {
+		return nil
+	}
+
+	// descendants go before their ancestors, so the latter are never detached
+	// before the former
+	sort.SliceStable(candidates, func(i int, j int) bool {
+		gameCountOne := candidates[i].node.State.GameCount
+		gameCountTwo := candidates[j].node.State.GameCount
+		if gameCountOne != gameCountTwo {
+			return gameCountOne < gameCountTwo
+		}
+
+		return candidates[i].depth > candidates[j].depth
+	})
+
+	var prunedNodes NodeGroup
+	for _, candidate := range candidates {
+		if nodeCount <= maximalNodeCount {
+			break
+		}
+
+		removedCount := subtreeSizes[candidate.node] - 1
+		nodeCount -= removedCount
+		prunedNodes = append(prunedNodes, candidate.node.Children...)
+		candidate.node.Children = nil
+
+		// so removed nodes aren't counted again, if an ancestor is pruned too
+		ancestor := candidate.node.Parent
+		for ancestor != nil && ancestor != node {
+			subtreeSizes[ancestor] -= removedCount
+			ancestor = ancestor.Parent
+		}
+	}
+
+	return prunedNodes
+}
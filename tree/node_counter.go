@@ -0,0 +1,67 @@
+package tree
+
+// NodeCounter ...
+//
+// It tracks an upper bound of the node count of a tree during building,
+// so the tree is recounted only when the bound reaches a limit. It assumes
+// that a pass of building adds no more nodes than there are points
+// on the board, i.e. it expands one leaf at most, like builders.TreeBuilder
+// does. Removing of nodes only makes the bound less tight.
+//
+// So checking of the limit after each of N passes costs O(N) amortized
+// instead of O(N^2), while the tree is far enough from the limit.
+//
+// It isn't safe for concurrent use.
+//
+type NodeCounter struct {
+	root          *Node
+	maximalGrowth int
+	pass          int
+	count         int
+}
+
+// NewNodeCounter ...
+//
+// It counts nodes of the tree at the zero pass.
+//
+func NewNodeCounter(root *Node) *NodeCounter {
+	size := root.Storage.Size()
+	return &NodeCounter{
+		root:          root,
+		maximalGrowth: size.Width * size.Height,
+		count:         root.NodeCount(),
+	}
+}
+
+// IsReached ...
+//
+// It checks that the node count of the tree after the passed count of passes
+// reaches the limit. Passes should be nondecreasing.
+//
+func (counter *NodeCounter) IsReached(pass int, limit int) bool {
+	bound := counter.count + (pass-counter.pass)*counter.maximalGrowth
+	if bound < limit {
+		return false
+	}
+
+	counter.Recount(pass)
+	return counter.count >= limit
+}
+
+// Recount ...
+//
+// It counts nodes of the tree after the passed count of passes, e.g. after
+// the tree was changed not by building.
+//
+func (counter *NodeCounter) Recount(pass int) {
+	counter.pass = pass
+	counter.count = counter.root.NodeCount()
+}
+
+// Count ...
+//
+// It returns the node count of the last recount.
+//
+func (counter *NodeCounter) Count() int {
+	return counter.count
+}
@@ -0,0 +1,41 @@
+package tree
+
+import (
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+func TestNodeCounter(test *testing.T) {
+	root := &Node{
+		Storage: models.NewBoard(models.Size{Width: 3, Height: 3}),
+	}
+	root.Children = NodeGroup{&Node{Parent: root}}
+
+	counter := NewNodeCounter(root)
+	if counter.Count() != 2 {
+		test.Fail()
+	}
+
+	// the tree isn't recounted while it can't reach the limit
+	root.Children = append(root.Children, &Node{Parent: root})
+	if counter.IsReached(1, 12) || counter.Count() != 2 {
+		test.Fail()
+	}
+
+	// the tree is recounted when it can reach the limit
+	if counter.IsReached(1, 11) || counter.Count() != 3 {
+		test.Fail()
+	}
+
+	root.Children = append(root.Children, &Node{Parent: root})
+	if !counter.IsReached(2, 4) || counter.Count() != 4 {
+		test.Fail()
+	}
+
+	root.Children = root.Children[:1]
+	counter.Recount(2)
+	if counter.IsReached(2, 3) || counter.Count() != 2 {
+		test.Fail()
+	}
+}
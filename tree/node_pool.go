@@ -0,0 +1,155 @@
+package tree
+
+import (
+	"sync"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+const nodePoolChunkSize = 1024
+
+// NodePool ...
+//
+// It allocates nodes and node groups in chunks and reuses freed ones, so it
+// reduces the load on the garbage collector.
+//
+// The nil pool is valid; it allocates nodes in the usual way and doesn't reuse
+// them.
+//
+// It's safe for concurrent use.
+//
+type NodePool struct {
+	locker       sync.Mutex
+	nodeChunk    []Node
+	groupChunk   []*Node
+	freeNodes    []*Node
+	freeGroups   map[int][]NodeGroup
+	createdCount int
+}
+
+// NewNodeGroup ...
+//
+// It's an equivalent of the function NewNodeGroup().
+//
+func (pool *NodePool) NewNodeGroup(
+	parent *Node,
+	moves []models.Move,
+) NodeGroup {
+	if pool == nil {
+		return NewNodeGroup(parent, moves)
+	}
+	if len(moves) == 0 {
+		return nil
+	}
+
+	pool.locker.Lock()
+	nodes := pool.newGroup(len(moves))
+	for index := range nodes {
+		nodes[index] = pool.newNode()
+	}
+	pool.locker.Unlock()
+
+	for index, move := range moves {
+		nodes[index].Parent = parent
+		nodes[index].Move = move
+		nodes[index].Storage = parent.Storage.ApplyMove(move)
+	}
+
+	return nodes
+}
+
+// Free ...
+//
+// It frees passed nodes and all their descendants. They shouldn't be used
+// after that.
+//
+func (pool *NodePool) Free(nodes ...*Node) {
+	if pool == nil {
+		return
+	}
+
+	pool.locker.Lock()
+	defer pool.locker.Unlock()
+
+	// copy the nodes so as not to spoil the passed slice
+	nodes = append([]*Node(nil), nodes...)
+	for len(nodes) > 0 {
+		node := nodes[len(nodes)-1]
+		nodes = nodes[:len(nodes)-1]
+
+		if len(node.Children) != 0 {
+			nodes = append(nodes, node.Children...)
+			pool.freeGroup(node.Children)
+		}
+
+		*node = Node{}
+		pool.freeNodes = append(pool.freeNodes, node)
+	}
+}
+
+// CreatedCount ...
+//
+// It returns a count of nodes that were actually created by the pool,
+// i.e. weren't reused.
+//
+func (pool *NodePool) CreatedCount() int {
+	pool.locker.Lock()
+	defer pool.locker.Unlock()
+
+	return pool.createdCount
+}
+
+func (pool *NodePool) newNode() *Node {
+	if len(pool.freeNodes) != 0 {
+		node := pool.freeNodes[len(pool.freeNodes)-1]
+		pool.freeNodes = pool.freeNodes[:len(pool.freeNodes)-1]
+
+		return node
+	}
+
+	if len(pool.nodeChunk) == 0 {
+		pool.nodeChunk = make([]Node, nodePoolChunkSize)
+	}
+
+	node := &pool.nodeChunk[0]
+	pool.nodeChunk = pool.nodeChunk[1:]
+	pool.createdCount++
+
+	return node
+}
+
+func (pool *NodePool) newGroup(length int) NodeGroup {
+	if groups := pool.freeGroups[length]; len(groups) != 0 {
+		group := groups[len(groups)-1]
+		pool.freeGroups[length] = groups[:len(groups)-1]
+
+		return group
+	}
+
+	if len(pool.groupChunk) < length {
+		chunkSize := nodePoolChunkSize
+		if chunkSize < length {
+			chunkSize = length
+		}
+
+		pool.groupChunk = make([]*Node, chunkSize)
+	}
+
+	group := NodeGroup(pool.groupChunk[:length:length])
+	pool.groupChunk = pool.groupChunk[length:]
+
+	return group
+}
+
+func (pool *NodePool) freeGroup(group NodeGroup) {
+	if pool.freeGroups == nil {
+		pool.freeGroups = make(map[int][]NodeGroup)
+	}
+
+	group = group[:cap(group)]
+	for index := range group {
+		group[index] = nil
+	}
+
+	pool.freeGroups[len(group)] = append(pool.freeGroups[len(group)], group)
+}
@@ -0,0 +1,102 @@
+package tree
+
+import (
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+func TestNodePoolNewNodeGroup(test *testing.T) {
+	type fields struct {
+		pool *NodePool
+	}
+	type data struct {
+		fields fields
+	}
+
+	for _, data := range []data{
+		{
+			fields: fields{nil},
+		},
+		{
+			fields: fields{new(NodePool)},
+		},
+	} {
+		parent := &Node{
+			Move: models.NewPreliminaryMove(models.Black),
+			Storage: models.NewBoard(
+				models.Size{
+					Width:  3,
+					Height: 3,
+				},
+			),
+		}
+		moves := []models.Move{
+			{
+				Color: models.Black,
+				Point: models.Point{
+					Column: 0,
+					Row:    0,
+				},
+			},
+			{
+				Color: models.Black,
+				Point: models.Point{
+					Column: 1,
+					Row:    1,
+				},
+			},
+		}
+		got := data.fields.pool.NewNodeGroup(parent, moves)
+
+		want := NewNodeGroup(parent, moves)
+		if !reflect.DeepEqual(got, want) {
+			test.Fail()
+		}
+
+		if got := data.fields.pool.NewNodeGroup(parent, nil); got != nil {
+			test.Fail()
+		}
+	}
+}
+
+func TestNodePoolFree(test *testing.T) {
+	parent := &Node{
+		Move: models.NewPreliminaryMove(models.Black),
+		Storage: models.NewBoard(
+			models.Size{
+				Width:  3,
+				Height: 3,
+			},
+		),
+	}
+	generator := models.MoveGenerator{}
+	moves, _ := generator.LegalMoves(parent.Storage, parent.Move)
+
+	var pool NodePool
+	parent.Children = pool.NewNodeGroup(parent, moves)
+	child := parent.Children[0]
+	child.Children = pool.NewNodeGroup(child, moves[1:])
+	if pool.CreatedCount() != 17 {
+		test.Fail()
+	}
+
+	pool.Free(parent.Children...)
+	if !reflect.DeepEqual(child, &Node{}) {
+		test.Fail()
+	}
+
+	parent.Children = pool.NewNodeGroup(parent, moves)
+	child = parent.Children[0]
+	child.Children = pool.NewNodeGroup(child, moves[1:])
+	if pool.CreatedCount() != 17 {
+		test.Fail()
+	}
+
+	want := NewNodeGroup(parent, moves)
+	want[0].Children = NewNodeGroup(want[0], moves[1:])
+	if !reflect.DeepEqual(parent.Children, want) {
+		test.Fail()
+	}
+}
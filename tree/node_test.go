@@ -133,6 +133,41 @@ func TestNodeShallowCopy(test *testing.T) {
 	}
 }
 
+func TestNodeDeepCopy(test *testing.T) {
+	parent := &Node{State: NodeState{GameCount: 10, WinCount: 5}}
+	node := &Node{
+		Parent:  parent,
+		Move:    models.Move{Color: models.Black, Point: models.Point{}},
+		Storage: models.NewBoard(models.Size{Width: 3, Height: 3}),
+		State:   NodeState{GameCount: 4, WinCount: 3},
+	}
+	child := &Node{
+		Parent: node,
+		Move: models.Move{
+			Color: models.White,
+			Point: models.Point{Column: 1},
+		},
+		State: NodeState{GameCount: 3, WinCount: 1},
+	}
+	node.Children = NodeGroup{child}
+	got := node.DeepCopy()
+
+	want := &Node{
+		Move:    node.Move,
+		Storage: node.Storage,
+		State:   node.State,
+	}
+	want.Children = NodeGroup{
+		&Node{Parent: want, Move: child.Move, State: child.State},
+	}
+	if !reflect.DeepEqual(got, want) {
+		test.Fail()
+	}
+	if got == node || got.Children[0] == child {
+		test.Fail()
+	}
+}
+
 func TestNodeUpdateState(test *testing.T) {
 	type fields struct {
 		parent *Node
@@ -436,6 +471,52 @@ func TestNodeMergeChildren(test *testing.T) {
 	}
 }
 
+func TestNodeFindChild(test *testing.T) {
+	moveOne := models.Move{Color: models.Black, Point: models.Point{}}
+	moveTwo := models.Move{Color: models.Black, Point: models.Point{Column: 1}}
+	root := &Node{}
+	child := &Node{Parent: root, Move: moveOne}
+	root.Children = NodeGroup{child}
+
+	if got := root.FindChild(moveOne); got != child {
+		test.Fail()
+	}
+	if got := root.FindChild(moveTwo); got != nil {
+		test.Fail()
+	}
+
+	var nilNode *Node
+	if got := nilNode.FindChild(moveOne); got != nil {
+		test.Fail()
+	}
+}
+
+func TestNodeFindOrNewChild(test *testing.T) {
+	moveOne := models.Move{Color: models.Black, Point: models.Point{}}
+	moveTwo := models.Move{Color: models.Black, Point: models.Point{Column: 1}}
+	board := models.NewBoard(models.Size{Width: 3, Height: 3})
+	root := &Node{Storage: board}
+	child := &Node{Parent: root, Move: moveOne}
+	root.Children = NodeGroup{child}
+
+	if got := root.FindOrNewChild(moveOne); got != child {
+		test.Fail()
+	}
+
+	got := root.FindOrNewChild(moveTwo)
+	want := &Node{
+		Parent:  root,
+		Move:    moveTwo,
+		Storage: board.ApplyMove(moveTwo),
+	}
+	if !reflect.DeepEqual(got, want) {
+		test.Fail()
+	}
+	if len(root.Children) != 1 {
+		test.Fail()
+	}
+}
+
 func TestNodeSelectLeaf(test *testing.T) {
 	type fields struct {
 		state    NodeState
@@ -1221,3 +1302,182 @@ func TestNodeExpandLeaf(test *testing.T) {
 		}
 	}
 }
+
+func TestNodePrincipalVariation(test *testing.T) {
+	root := &Node{
+		State: NodeState{
+			GameCount: 10,
+			WinCount:  5,
+		},
+	}
+	childOne := &Node{
+		Parent: root,
+		State: NodeState{
+			GameCount: 3,
+			WinCount:  2,
+		},
+	}
+	childTwo := &Node{
+		Parent: root,
+		State: NodeState{
+			GameCount: 7,
+			WinCount:  3,
+		},
+	}
+	childTwoOne := &Node{
+		Parent: childTwo,
+		State: NodeState{
+			GameCount: 6,
+			WinCount:  4,
+		},
+	}
+	root.Children = NodeGroup{childOne, childTwo}
+	childTwo.Children = NodeGroup{childTwoOne}
+
+	selector := MockNodeSelector{
+		selectNode: func(nodes NodeGroup) *Node {
+			return nodes[len(nodes)-1]
+		},
+	}
+	got := root.PrincipalVariation(selector)
+
+	want := NodeGroup{childTwo, childTwoOne}
+	if !reflect.DeepEqual(got, want) {
+		test.Fail()
+	}
+
+	if got := childOne.PrincipalVariation(selector); len(got) != 0 {
+		test.Fail()
+	}
+}
+
+func TestNodeNodeCount(test *testing.T) {
+	root := &Node{}
+	childOne := &Node{Parent: root}
+	childTwo := &Node{Parent: root}
+	childTwoOne := &Node{Parent: childTwo}
+	root.Children = NodeGroup{childOne, childTwo}
+	childTwo.Children = NodeGroup{childTwoOne}
+
+	if got := root.NodeCount(); got != 4 {
+		test.Fail()
+	}
+	if got := childTwo.NodeCount(); got != 2 {
+		test.Fail()
+	}
+}
+
+func TestNodePrune(test *testing.T) {
+	type args struct {
+		maximalNodeCount int
+	}
+	type data struct {
+		args            args
+		wantNodeCount   int
+		wantPrunedMoves []models.Point
+	}
+
+	for _, data := range []data{
+		{
+			args:            args{10},
+			wantNodeCount:   8,
+			wantPrunedMoves: nil,
+		},
+		{
+			args:          args{7},
+			wantNodeCount: 7,
+			wantPrunedMoves: []models.Point{
+				{Column: 1, Row: 2},
+			},
+		},
+		{
+			args:          args{5},
+			wantNodeCount: 5,
+			wantPrunedMoves: []models.Point{
+				{Column: 1, Row: 2},
+				{Column: 2, Row: 1},
+				{Column: 2, Row: 2},
+			},
+		},
+		{
+			args:          args{1},
+			wantNodeCount: 3,
+			wantPrunedMoves: []models.Point{
+				{Column: 1, Row: 2},
+				{Column: 2, Row: 1},
+				{Column: 2, Row: 2},
+				{Column: 0, Row: 1},
+				{Column: 1, Row: 1},
+			},
+		},
+	} {
+		// root
+		// +-- (0, 0): 10 games
+		// |   +-- (0, 1): 6 games
+		// |   +-- (1, 1): 3 games
+		// |       +-- (1, 2): 2 games
+		// +-- (1, 0): 4 games
+		//     +-- (2, 1): 2 games
+		//     +-- (2, 2): 1 game
+		newNode := func(parent *Node, column int, row int, gameCount int) *Node {
+			node := &Node{
+				Parent: parent,
+				Move: models.Move{
+					Point: models.Point{Column: column, Row: row},
+				},
+				State: NodeState{GameCount: gameCount},
+			}
+			if parent != nil {
+				parent.Children = append(parent.Children, node)
+			}
+
+			return node
+		}
+		root := newNode(nil, -1, -1, 14)
+		childOne := newNode(root, 0, 0, 10)
+		newNode(childOne, 0, 1, 6)
+		childOneTwo := newNode(childOne, 1, 1, 3)
+		newNode(childOneTwo, 1, 2, 2)
+		childTwo := newNode(root, 1, 0, 4)
+		newNode(childTwo, 2, 1, 2)
+		newNode(childTwo, 2, 2, 1)
+
+		prunedNodes := root.Prune(data.args.maximalNodeCount)
+
+		var prunedMoves []models.Point
+		for _, node := range prunedNodes {
+			prunedMoves = append(prunedMoves, node.Move.Point)
+		}
+		if !reflect.DeepEqual(prunedMoves, data.wantPrunedMoves) {
+			test.Fail()
+		}
+
+		if got := root.NodeCount(); got != data.wantNodeCount {
+			test.Fail()
+		}
+		if len(root.Children) != 2 {
+			test.Fail()
+		}
+	}
+}
+
+func TestNodeDepth(test *testing.T) {
+	root := &Node{}
+	child := &Node{Parent: root}
+	grandchild := &Node{Parent: child}
+
+	for depth, node := range []*Node{root, child, grandchild} {
+		if node.Depth(root) != depth {
+			test.Fail()
+		}
+		if node.Depth(nil) != depth {
+			test.Fail()
+		}
+	}
+
+	for depth, node := range []*Node{child, grandchild} {
+		if node.Depth(child) != depth {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,80 @@
+package tree
+
+import (
+	"errors"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+// ...
+var (
+	ErrInconsistentHistory = errors.New("inconsistent history")
+)
+
+// Position ...
+//
+// It describes a position with an explicit side to move, so a setup position
+// can be searched without a previous move.
+//
+// The history is optional; it's ordered from the first move to the last one.
+//
+type Position struct {
+	Storage    models.StoneStorage
+	SideToMove models.Color
+	History    []models.Move
+}
+
+// Validate ...
+//
+// It checks that the last move of the history is made by the opponent
+// of the side to move and is placed on the board.
+//
+func (position Position) Validate() error {
+	if len(position.History) == 0 {
+		return nil
+	}
+
+	lastMove := position.History[len(position.History)-1]
+	if lastMove.Color != position.SideToMove.Negative() {
+		return ErrInconsistentHistory
+	}
+
+	color, ok := position.Storage.Stone(lastMove.Point)
+	if !ok || color != lastMove.Color {
+		return ErrInconsistentHistory
+	}
+
+	return nil
+}
+
+// PreviousMove ...
+//
+// It returns the last move of the history or a preliminary move for the side
+// to move, if the history is empty. An inconsistent history isn't replaced
+// by a preliminary move.
+//
+// Returned error can be ErrInconsistentHistory only.
+//
+func (position Position) PreviousMove() (models.Move, error) {
+	if err := position.Validate(); err != nil {
+		return models.Move{}, err
+	}
+	if len(position.History) == 0 {
+		return models.NewPreliminaryMove(position.SideToMove), nil
+	}
+
+	return position.History[len(position.History)-1], nil
+}
+
+// NewRoot ...
+//
+// Returned error can be ErrInconsistentHistory only.
+//
+func (position Position) NewRoot() (*Node, error) {
+	previousMove, err := position.PreviousMove()
+	if err != nil {
+		return nil, err
+	}
+
+	return &Node{Move: previousMove, Storage: position.Storage}, nil
+}
@@ -0,0 +1,83 @@
+package tree
+
+import (
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+)
+
+func TestPositionValidate(test *testing.T) {
+	lastMove := models.Move{Color: models.White, Point: models.Point{}}
+	storage := models.NewBoard(models.Size{Width: 3, Height: 3}).
+		ApplyMove(lastMove)
+
+	type data struct {
+		position     Position
+		wantErr      error
+		wantPrevious models.Move
+	}
+
+	for _, data := range []data{
+		{
+			position: Position{
+				Storage:    storage,
+				SideToMove: models.Black,
+			},
+			wantErr:      nil,
+			wantPrevious: models.NewPreliminaryMove(models.Black),
+		},
+		{
+			position: Position{
+				Storage:    storage,
+				SideToMove: models.Black,
+				History:    []models.Move{lastMove},
+			},
+			wantErr:      nil,
+			wantPrevious: lastMove,
+		},
+		{
+			position: Position{
+				Storage:    storage,
+				SideToMove: models.White,
+				History:    []models.Move{lastMove},
+			},
+			wantErr:      ErrInconsistentHistory,
+			wantPrevious: models.Move{},
+		},
+		{
+			position: Position{
+				Storage:    storage,
+				SideToMove: models.Black,
+				History: []models.Move{
+					{Color: models.White, Point: models.Point{Column: 1}},
+				},
+			},
+			wantErr:      ErrInconsistentHistory,
+			wantPrevious: models.Move{},
+		},
+	} {
+		err := data.position.Validate()
+		previousMove, previousMoveErr := data.position.PreviousMove()
+		root, rootErr := data.position.NewRoot()
+
+		var wantRoot *Node
+		if data.wantErr == nil {
+			wantRoot = &Node{
+				Move:    data.wantPrevious,
+				Storage: data.position.Storage,
+			}
+		}
+		if err != data.wantErr ||
+			previousMoveErr != data.wantErr ||
+			rootErr != data.wantErr {
+			test.Fail()
+		}
+		if !reflect.DeepEqual(previousMove, data.wantPrevious) {
+			test.Fail()
+		}
+		if !reflect.DeepEqual(root, wantRoot) {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,264 @@
+package serialization
+
+import (
+	"bufio"
+	"encoding/binary"
+	"io"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/boards"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+	"github.com/thewizardplusplus/go-atari-montecarlo/varints"
+)
+
+const (
+	binarySignature = "AMCT"
+	binaryVersion   = 1
+)
+
+// BinaryCodec ...
+//
+// It uses the following format (all numbers are varints):
+//
+//   - the signature and the format version;
+//   - the size and the stones of the root storage;
+//   - the root move;
+//   - nodes in the depth-first order: the node state, the child count
+//     and the children.
+//
+// Moves of children are stored as point indices only, because their colors
+// are always opposite to colors of their parents.
+//
+// Storages of children aren't stored; they are recomputed from the root storage
+// on decoding.
+//
+type BinaryCodec struct{}
+
+// Encode ...
+func (codec BinaryCodec) Encode(writer io.Writer, root *tree.Node) error {
+	binaryWriter := varints.NewWriter(writer)
+	binaryWriter.Write([]byte(binarySignature))
+	binaryWriter.WriteUvarint(binaryVersion)
+
+	size := root.Storage.Size()
+	binaryWriter.WriteUvarint(uint64(size.Width))
+	binaryWriter.WriteUvarint(uint64(size.Height))
+
+	rootStones := boards.Stones(root.Storage)
+	binaryWriter.WriteUvarint(uint64(len(rootStones)))
+	for _, stone := range rootStones {
+		binaryWriter.WriteUvarint(uint64(stone.Color))
+		binaryWriter.WriteUvarint(pointIndex(size, stone.Point))
+	}
+
+	if err := checkColor(root.Move.Color); err != nil {
+		return err
+	}
+	binaryWriter.WriteUvarint(uint64(root.Move.Color))
+	binaryWriter.WriteVarint(int64(root.Move.Point.Column))
+	binaryWriter.WriteVarint(int64(root.Move.Point.Row))
+
+	if err := encodeBinaryNode(binaryWriter, size, root); err != nil {
+		return err
+	}
+
+	return binaryWriter.Flush()
+}
+
+// Decode ...
+//
+// Returned error can be ErrInvalidData or an error of the reader.
+//
+func (codec BinaryCodec) Decode(reader io.Reader) (*tree.Node, error) {
+	binaryReader := bufio.NewReader(reader)
+
+	signature := make([]byte, len(binarySignature))
+	if _, err := io.ReadFull(binaryReader, signature); err != nil {
+		return nil, err
+	}
+	if string(signature) != binarySignature {
+		return nil, ErrInvalidData
+	}
+
+	version, err := binary.ReadUvarint(binaryReader)
+	if err != nil {
+		return nil, err
+	}
+	if version != binaryVersion {
+		return nil, ErrInvalidData
+	}
+
+	var size models.Size
+	for _, dimension := range []*int{&size.Width, &size.Height} {
+		value, err := varints.ReadInt(binaryReader, boards.MaximalSide)
+		if err != nil {
+			return nil, err
+		}
+
+		*dimension = value
+	}
+	if size.Width == 0 || size.Height == 0 {
+		return nil, ErrInvalidData
+	}
+
+	pointCount := size.Width * size.Height
+	stoneCount, err := varints.ReadInt(binaryReader, pointCount)
+	if err != nil {
+		return nil, err
+	}
+
+	rootStones := make([]models.Move, 0, stoneCount)
+	for i := 0; i < stoneCount; i++ {
+		color, err := readColor(binaryReader)
+		if err != nil {
+			return nil, err
+		}
+
+		point, err := readPoint(binaryReader, size)
+		if err != nil {
+			return nil, err
+		}
+
+		rootStones = append(rootStones, models.Move{Color: color, Point: point})
+	}
+
+	storage, err := newStorage(size, rootStones)
+	if err != nil {
+		return nil, err
+	}
+
+	color, err := readColor(binaryReader)
+	if err != nil {
+		return nil, err
+	}
+
+	var coordinates [2]int64
+	for index := range coordinates {
+		coordinate, err := binary.ReadVarint(binaryReader)
+		if err != nil {
+			return nil, err
+		}
+
+		coordinates[index] = coordinate
+	}
+
+	root := &tree.Node{
+		Move: models.Move{
+			Color: color,
+			Point: models.Point{
+				Column: int(coordinates[0]),
+				Row:    int(coordinates[1]),
+			},
+		},
+		Storage: storage,
+	}
+	if err := decodeBinaryNode(binaryReader, root); err != nil {
+		return nil, err
+	}
+
+	return root, nil
+}
+
+func encodeBinaryNode(
+	writer *varints.Writer,
+	size models.Size,
+	node *tree.Node,
+) error {
+	if err := checkState(node.State); err != nil {
+		return err
+	}
+
+	writer.WriteUvarint(uint64(node.State.GameCount))
+	writer.WriteUvarint(uint64(node.State.WinCount))
+
+	writer.WriteUvarint(uint64(len(node.Children)))
+	for _, child := range node.Children {
+		if child.Move.Color != node.Move.Color.Negative() {
+			return ErrInvalidData
+		}
+		if err := checkMove(node.Storage, child.Move); err != nil {
+			return err
+		}
+
+		writer.WriteUvarint(pointIndex(size, child.Move.Point))
+		if err := encodeBinaryNode(writer, size, child); err != nil {
+			return err
+		}
+	}
+
+	return writer.Err()
+}
+
+func decodeBinaryNode(reader *bufio.Reader, node *tree.Node) error {
+	var counts [2]int
+	for index := range counts {
+		count, err := varints.ReadInt(reader, varints.MaximalInt)
+		if err != nil {
+			return err
+		}
+
+		counts[index] = count
+	}
+
+	state := tree.NodeState{GameCount: counts[0], WinCount: counts[1]}
+	if err := checkState(state); err != nil {
+		return err
+	}
+	node.State = state
+
+	size := node.Storage.Size()
+	childCount, err := varints.ReadInt(reader, size.Width*size.Height)
+	if err != nil {
+		return err
+	}
+
+	// children aren't preallocated by the count from the untrusted data,
+	// so the allocated memory is bounded by the data length
+	for i := 0; i < childCount; i++ {
+		point, err := readPoint(reader, size)
+		if err != nil {
+			return err
+		}
+
+		move := models.Move{Color: node.Move.Color.Negative(), Point: point}
+		child, err := newChild(node, move, tree.NodeState{})
+		if err != nil {
+			return err
+		}
+		if err := decodeBinaryNode(reader, child); err != nil {
+			return err
+		}
+
+		node.Children = append(node.Children, child)
+	}
+
+	return nil
+}
+
+func readColor(reader io.ByteReader) (models.Color, error) {
+	value, err := varints.ReadInt(reader, int(models.White))
+	if err != nil {
+		return 0, err
+	}
+
+	color := models.Color(value)
+	if err := checkColor(color); err != nil {
+		return 0, err
+	}
+
+	return color, nil
+}
+
+func readPoint(reader io.ByteReader, size models.Size) (models.Point, error) {
+	index, err := varints.ReadInt(reader, size.Width*size.Height-1)
+	if err != nil {
+		return models.Point{}, err
+	}
+
+	point := models.Point{Column: index % size.Width, Row: index / size.Width}
+	return point, nil
+}
+
+func pointIndex(size models.Size, point models.Point) uint64 {
+	return uint64(point.Row*size.Width + point.Column)
+}
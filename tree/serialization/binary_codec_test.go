@@ -0,0 +1,199 @@
+package serialization
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/boards"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestBinaryCodec(test *testing.T) {
+	type args struct {
+		root *tree.Node
+	}
+	type data struct {
+		args args
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				root: &tree.Node{
+					Move:    models.NewPreliminaryMove(models.Black),
+					Storage: models.NewBoard(models.Size{Width: 3, Height: 3}),
+				},
+			},
+		},
+		{
+			args: args{
+				root: newTestTree(),
+			},
+		},
+	} {
+		var buffer bytes.Buffer
+		err := BinaryCodec{}.Encode(&buffer, data.args.root)
+		if err != nil {
+			test.Fail()
+		}
+
+		got, err := BinaryCodec{}.Decode(&buffer)
+		if err != nil {
+			test.Fail()
+		}
+
+		if !reflect.DeepEqual(got, data.args.root) {
+			test.Fail()
+		}
+	}
+}
+
+func TestBinaryCodecEncode_withInvalidData(test *testing.T) {
+	root := newTestTree()
+	root.Children[1].State = tree.NodeState{GameCount: 2, WinCount: 3}
+
+	var buffer bytes.Buffer
+	err := BinaryCodec{}.Encode(&buffer, root)
+
+	if err != ErrInvalidData {
+		test.Fail()
+	}
+}
+
+func TestBinaryCodecDecode_withInvalidData(test *testing.T) {
+	type args struct {
+		data func(data []byte) []byte
+	}
+	type data struct {
+		args    args
+		wantErr func(err error) bool
+	}
+
+	for _, data := range []data{
+		{
+			args: args{
+				data: func(data []byte) []byte {
+					return append([]byte("ABCD"), data[len(binarySignature):]...)
+				},
+			},
+			wantErr: func(err error) bool { return err == ErrInvalidData },
+		},
+		{
+			args: args{
+				data: func(data []byte) []byte {
+					// replace the state of the last child with an invalid one
+					data[len(data)-3] = 0
+					return data
+				},
+			},
+			wantErr: func(err error) bool { return err == ErrInvalidData },
+		},
+		{
+			args: args{
+				data: func(data []byte) []byte {
+					return data[:len(data)-1]
+				},
+			},
+			wantErr: func(err error) bool { return err != nil },
+		},
+		{
+			args: args{
+				data: func(data []byte) []byte {
+					// replace the last child move with an occupied point
+					data[len(data)-4] = 0
+					return data
+				},
+			},
+			wantErr: func(err error) bool { return err == ErrInvalidData },
+		},
+		{
+			args: args{
+				data: func(data []byte) []byte {
+					// replace the width with an oversized one
+					data[len(binarySignature)+1] = boards.MaximalSide + 1
+					return data
+				},
+			},
+			wantErr: func(err error) bool { return err == ErrInvalidData },
+		},
+	} {
+		var buffer bytes.Buffer
+		err := BinaryCodec{}.Encode(&buffer, newTestTree())
+		if err != nil {
+			test.Fail()
+		}
+
+		reader := bytes.NewReader(data.args.data(buffer.Bytes()))
+		got, err := BinaryCodec{}.Decode(reader)
+
+		if got != nil {
+			test.Fail()
+		}
+		if !data.wantErr(err) {
+			test.Fail()
+		}
+	}
+}
+
+// +--+--+--+
+// |B |B1|  |
+// +--+--+--+
+// |W2|  |  |
+// +--+--+--+
+// |  |B1|W |
+// +--+--+--+
+func newTestTree() *tree.Node {
+	storage := models.NewBoard(models.Size{Width: 3, Height: 3})
+	for _, move := range []models.Move{
+		{
+			Color: models.Black,
+			Point: models.Point{Column: 0, Row: 0},
+		},
+		{
+			Color: models.White,
+			Point: models.Point{Column: 2, Row: 2},
+		},
+	} {
+		storage = storage.ApplyMove(move)
+	}
+
+	root := &tree.Node{
+		Move: models.Move{
+			Color: models.White,
+			Point: models.Point{Column: 2, Row: 2},
+		},
+		Storage: storage,
+		State:   tree.NodeState{GameCount: 5, WinCount: 2},
+	}
+	childOne :=
+		newTestChild(root, 1, 0, tree.NodeState{GameCount: 2, WinCount: 1})
+	childTwo :=
+		newTestChild(root, 1, 2, tree.NodeState{GameCount: 3, WinCount: 2})
+	root.Children = tree.NodeGroup{childOne, childTwo}
+
+	childOneOne :=
+		newTestChild(childOne, 0, 1, tree.NodeState{GameCount: 1, WinCount: 0})
+	childOne.Children = tree.NodeGroup{childOneOne}
+
+	return root
+}
+
+func newTestChild(
+	parent *tree.Node,
+	column int,
+	row int,
+	state tree.NodeState,
+) *tree.Node {
+	move := models.Move{
+		Color: parent.Move.Color.Negative(),
+		Point: models.Point{Column: column, Row: row},
+	}
+	return &tree.Node{
+		Parent:  parent,
+		Move:    move,
+		Storage: parent.Storage.ApplyMove(move),
+		State:   state,
+	}
+}
@@ -0,0 +1,212 @@
+package serialization
+
+import (
+	"encoding/json"
+	"io"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/boards"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// JSONCodec ...
+//
+// It's intended for debugging, so it stores nodes as nested objects and colors
+// as strings.
+//
+// Storages of children aren't stored; they are recomputed from the root storage
+// on decoding.
+//
+type JSONCodec struct {
+	// If it's empty, the output isn't indented.
+	Indent string
+}
+
+type jsonTree struct {
+	Size   jsonSize   `json:"size"`
+	Stones []jsonMove `json:"stones"`
+	Root   jsonNode   `json:"root"`
+}
+
+type jsonSize struct {
+	Width  int `json:"width"`
+	Height int `json:"height"`
+}
+
+type jsonMove struct {
+	Color  string `json:"color"`
+	Column int    `json:"column"`
+	Row    int    `json:"row"`
+}
+
+type jsonNode struct {
+	Move      jsonMove   `json:"move"`
+	GameCount int        `json:"game_count"`
+	WinCount  int        `json:"win_count"`
+	Children  []jsonNode `json:"children,omitempty"`
+}
+
+var (
+	colorNames = map[models.Color]string{
+		models.Black: "black",
+		models.White: "white",
+	}
+)
+
+// Encode ...
+func (codec JSONCodec) Encode(writer io.Writer, root *tree.Node) error {
+	rootStones := boards.Stones(root.Storage)
+	jsonStones := make([]jsonMove, 0, len(rootStones))
+	for _, stone := range rootStones {
+		jsonStone, err := newJSONMove(stone)
+		if err != nil {
+			return err
+		}
+
+		jsonStones = append(jsonStones, jsonStone)
+	}
+
+	jsonRoot, err := newJSONNode(root)
+	if err != nil {
+		return err
+	}
+
+	size := root.Storage.Size()
+	data := jsonTree{
+		Size:   jsonSize{Width: size.Width, Height: size.Height},
+		Stones: jsonStones,
+		Root:   jsonRoot,
+	}
+
+	encoder := json.NewEncoder(writer)
+	if codec.Indent != "" {
+		encoder.SetIndent("", codec.Indent)
+	}
+
+	return encoder.Encode(data)
+}
+
+// Decode ...
+//
+// Returned error can be ErrInvalidData or an error of the reader.
+//
+func (codec JSONCodec) Decode(reader io.Reader) (*tree.Node, error) {
+	var data jsonTree
+	if err := json.NewDecoder(reader).Decode(&data); err != nil {
+		return nil, err
+	}
+
+	rootStones := make([]models.Move, 0, len(data.Stones))
+	for _, jsonStone := range data.Stones {
+		stone, err := jsonStone.move()
+		if err != nil {
+			return nil, err
+		}
+
+		rootStones = append(rootStones, stone)
+	}
+
+	size := models.Size{Width: data.Size.Width, Height: data.Size.Height}
+	storage, err := newStorage(size, rootStones)
+	if err != nil {
+		return nil, err
+	}
+
+	move, err := data.Root.Move.move()
+	if err != nil {
+		return nil, err
+	}
+
+	state := tree.NodeState{
+		GameCount: data.Root.GameCount,
+		WinCount:  data.Root.WinCount,
+	}
+	if err := checkState(state); err != nil {
+		return nil, err
+	}
+
+	root := &tree.Node{Move: move, Storage: storage, State: state}
+	if err := decodeJSONChildren(root, data.Root.Children); err != nil {
+		return nil, err
+	}
+
+	return root, nil
+}
+
+func newJSONMove(move models.Move) (jsonMove, error) {
+	colorName, ok := colorNames[move.Color]
+	if !ok {
+		return jsonMove{}, ErrInvalidData
+	}
+
+	data := jsonMove{
+		Color:  colorName,
+		Column: move.Point.Column,
+		Row:    move.Point.Row,
+	}
+	return data, nil
+}
+
+func (data jsonMove) move() (models.Move, error) {
+	for color, colorName := range colorNames {
+		if colorName == data.Color {
+			move := models.Move{
+				Color: color,
+				Point: models.Point{Column: data.Column, Row: data.Row},
+			}
+			return move, nil
+		}
+	}
+
+	return models.Move{}, ErrInvalidData
+}
+
+func newJSONNode(node *tree.Node) (jsonNode, error) {
+	move, err := newJSONMove(node.Move)
+	if err != nil {
+		return jsonNode{}, err
+	}
+
+	var children []jsonNode
+	for _, child := range node.Children {
+		jsonChild, err := newJSONNode(child)
+		if err != nil {
+			return jsonNode{}, err
+		}
+
+		children = append(children, jsonChild)
+	}
+
+	data := jsonNode{
+		Move:      move,
+		GameCount: node.State.GameCount,
+		WinCount:  node.State.WinCount,
+		Children:  children,
+	}
+	return data, nil
+}
+
+func decodeJSONChildren(node *tree.Node, jsonChildren []jsonNode) error {
+	for _, jsonChild := range jsonChildren {
+		move, err := jsonChild.Move.move()
+		if err != nil {
+			return err
+		}
+
+		state := tree.NodeState{
+			GameCount: jsonChild.GameCount,
+			WinCount:  jsonChild.WinCount,
+		}
+		child, err := newChild(node, move, state)
+		if err != nil {
+			return err
+		}
+		if err := decodeJSONChildren(child, jsonChild.Children); err != nil {
+			return err
+		}
+
+		node.Children = append(node.Children, child)
+	}
+
+	return nil
+}
@@ -0,0 +1,85 @@
+package serialization
+
+import (
+	"bytes"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestJSONCodec(test *testing.T) {
+	var buffer bytes.Buffer
+	root := newTestTree()
+	err := JSONCodec{}.Encode(&buffer, root)
+	if err != nil {
+		test.Fail()
+	}
+
+	got, err := JSONCodec{}.Decode(&buffer)
+	if err != nil {
+		test.Fail()
+	}
+
+	if !reflect.DeepEqual(got, root) {
+		test.Fail()
+	}
+}
+
+func TestJSONCodecEncode(test *testing.T) {
+	root := newTestTree()
+	root.Children = tree.NodeGroup{root.Children[1]}
+
+	var buffer bytes.Buffer
+	err := JSONCodec{}.Encode(&buffer, root)
+	if err != nil {
+		test.Fail()
+	}
+
+	want := `{"size":{"width":3,"height":3},` +
+		`"stones":[` +
+		`{"color":"black","column":0,"row":0},` +
+		`{"color":"white","column":2,"row":2}` +
+		`],` +
+		`"root":{` +
+		`"move":{"color":"white","column":2,"row":2},` +
+		`"game_count":5,"win_count":2,` +
+		`"children":[{` +
+		`"move":{"color":"black","column":1,"row":2},` +
+		`"game_count":3,"win_count":2` +
+		`}]` +
+		`}}` + "\n"
+	if buffer.String() != want {
+		test.Fail()
+	}
+}
+
+func TestJSONCodecDecode_withInvalidData(test *testing.T) {
+	for _, data := range []string{
+		`{"size":{"width":0,"height":3},"root":{"move":{"color":"white"}}}`,
+		`{"size":{"width":3,"height":3},"root":{"move":{"color":"red"}}}`,
+		`{` +
+			`"size":{"width":3,"height":3},` +
+			`"stones":[{"color":"black","column":3,"row":0}],` +
+			`"root":{"move":{"color":"white"}}` +
+			`}`,
+		`{` +
+			`"size":{"width":3,"height":3},` +
+			`"root":{` +
+			`"move":{"color":"white"},` +
+			`"game_count":1,"win_count":1,` +
+			`"children":[{"move":{"color":"white","column":1,"row":1}}]` +
+			`}` +
+			`}`,
+	} {
+		got, err := JSONCodec{}.Decode(strings.NewReader(data))
+
+		if got != nil {
+			test.Fail()
+		}
+		if err != ErrInvalidData {
+			test.Fail()
+		}
+	}
+}
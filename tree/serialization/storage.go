@@ -0,0 +1,104 @@
+package serialization
+
+import (
+	"io"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/boards"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+	"github.com/thewizardplusplus/go-atari-montecarlo/varints"
+)
+
+// ...
+var (
+	ErrInvalidData = varints.ErrInvalidData
+)
+
+// Encoder ...
+type Encoder interface {
+	Encode(writer io.Writer, root *tree.Node) error
+}
+
+// Decoder ...
+type Decoder interface {
+	Decode(reader io.Reader) (*tree.Node, error)
+}
+
+func newStorage(size models.Size, stones []models.Move) (
+	models.StoneStorage,
+	error,
+) {
+	if size.Width <= 0 || size.Width > boards.MaximalSide ||
+		size.Height <= 0 || size.Height > boards.MaximalSide {
+		return nil, ErrInvalidData
+	}
+
+	storage := models.NewBoard(size)
+	for _, stone := range stones {
+		if err := checkMove(storage, stone); err != nil {
+			return nil, err
+		}
+
+		storage = storage.ApplyMove(stone)
+	}
+
+	return storage, nil
+}
+
+func newChild(parent *tree.Node, move models.Move, state tree.NodeState) (
+	*tree.Node,
+	error,
+) {
+	if move.Color != parent.Move.Color.Negative() {
+		return nil, ErrInvalidData
+	}
+	if err := checkMove(parent.Storage, move); err != nil {
+		return nil, err
+	}
+	if err := checkState(state); err != nil {
+		return nil, err
+	}
+
+	child := &tree.Node{
+		Parent:  parent,
+		Move:    move,
+		Storage: parent.Storage.ApplyMove(move),
+		State:   state,
+	}
+	return child, nil
+}
+
+func checkColor(color models.Color) error {
+	if color != models.Black && color != models.White {
+		return ErrInvalidData
+	}
+
+	return nil
+}
+
+func checkMove(storage models.StoneStorage, move models.Move) error {
+	if err := checkColor(move.Color); err != nil {
+		return err
+	}
+
+	size := storage.Size()
+	if move.Point.Column < 0 || move.Point.Column >= size.Width ||
+		move.Point.Row < 0 || move.Point.Row >= size.Height {
+		return ErrInvalidData
+	}
+	if _, ok := storage.Stone(move.Point); ok {
+		return ErrInvalidData
+	}
+
+	return nil
+}
+
+func checkState(state tree.NodeState) error {
+	if state.GameCount < 0 ||
+		state.WinCount < 0 ||
+		state.WinCount > state.GameCount {
+		return ErrInvalidData
+	}
+
+	return nil
+}
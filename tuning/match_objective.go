@@ -0,0 +1,94 @@
+package tuning
+
+import (
+	"github.com/thewizardplusplus/go-atari-montecarlo/configs"
+	"github.com/thewizardplusplus/go-atari-montecarlo/selfplay"
+)
+
+// MatchObjective ...
+//
+// It compares two sets of values of config parameters by a self-play match
+// between searchers created by corresponding configs. The searchers are
+// seeded by the match (see selfplay.Match.PlaySeeded()), so a comparison
+// is reproducible, if budgets of the configs don't include
+// the maximal duration.
+//
+type MatchObjective struct {
+	BaseConfig configs.Config
+	Parameters []configs.Parameter
+	Match      selfplay.Match
+}
+
+// Compare ...
+//
+// It returns the difference between win and loss rates of the first set.
+//
+// Returned error can be configs.ErrInvalidConfig or an error of a searcher.
+//
+func (objective MatchObjective) Compare(
+	plus []float64,
+	minus []float64,
+) (float64, error) {
+	plusConfig, minusConfig := objective.Config(plus), objective.Config(minus)
+	for _, config := range []configs.Config{plusConfig, minusConfig} {
+		if err := config.Validate(); err != nil {
+			return 0, err
+		}
+	}
+
+	result, err := objective.Match.PlaySeeded(
+		newSearcherFactory(plusConfig),
+		newSearcherFactory(minusConfig),
+	)
+	if err != nil {
+		return 0, err
+	}
+	if result.GameCount() == 0 {
+		return 0, nil
+	}
+
+	difference := result.WinCount - result.LossCount
+	return float64(difference) / float64(result.GameCount()), nil
+}
+
+// Config ...
+//
+// It returns the base config with the values of the parameters.
+//
+func (objective MatchObjective) Config(values []float64) configs.Config {
+	config := objective.BaseConfig
+	for index, parameter := range objective.Parameters {
+		parameter.Set(&config, values[index])
+	}
+
+	return config
+}
+
+// NewParameters ...
+//
+// It converts config parameters to tuning ones with values
+// from the base config.
+//
+func (objective MatchObjective) NewParameters() []Parameter {
+	var parameters []Parameter
+	for _, parameter := range objective.Parameters {
+		parameters = append(parameters, Parameter{
+			Name:      parameter.Name,
+			Minimum:   parameter.Minimum,
+			Maximum:   parameter.Maximum,
+			Value:     parameter.Get(objective.BaseConfig),
+			IsInteger: parameter.IsInteger,
+		})
+	}
+
+	return parameters
+}
+
+func newSearcherFactory(config configs.Config) selfplay.SearcherFactory {
+	return selfplay.SearcherFactoryFunc(func(seed int64) (
+		selfplay.Searcher,
+		error,
+	) {
+		return config.NewSeededSearcher(seed)
+	})
+}
@@ -0,0 +1,68 @@
+package tuning
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/thewizardplusplus/go-atari-montecarlo/configs"
+)
+
+func TestMatchObjectiveConfig(test *testing.T) {
+	objective := MatchObjective{
+		BaseConfig: configs.Config{UCBFactor: 1, MaximalPass: 10},
+		Parameters: newTestParameters(),
+	}
+	got := objective.Config([]float64{2.5, 20})
+
+	want := configs.Config{UCBFactor: 2.5, MaximalPass: 10, MaximalDepth: 20}
+	if !reflect.DeepEqual(got, want) {
+		test.Fail()
+	}
+}
+
+func TestMatchObjectiveNewParameters(test *testing.T) {
+	objective := MatchObjective{
+		BaseConfig: configs.Config{UCBFactor: 1, MaximalPass: 10},
+		Parameters: newTestParameters(),
+	}
+	got := objective.NewParameters()
+
+	want := []Parameter{
+		{Name: "ucb_factor", Minimum: 0, Maximum: 4, Value: 1},
+		{
+			Name:      "maximal_depth",
+			Minimum:   1,
+			Maximum:   64,
+			Value:     0,
+			IsInteger: true,
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		test.Fail()
+	}
+}
+
+func TestMatchObjectiveCompare_withError(test *testing.T) {
+	objective := MatchObjective{
+		BaseConfig: configs.Config{UCBFactor: 1, MaximalPass: 10},
+		Parameters: newTestParameters(),
+	}
+	score, err := objective.Compare([]float64{-1, 1}, []float64{1, 1})
+
+	if score != 0 {
+		test.Fail()
+	}
+	if err != configs.ErrInvalidConfig {
+		test.Fail()
+	}
+}
+
+func newTestParameters() []configs.Parameter {
+	var parameters []configs.Parameter
+	for _, name := range []string{"ucb_factor", "maximal_depth"} {
+		parameter, _ := configs.FindParameter(name)
+		parameters = append(parameters, parameter)
+	}
+
+	return parameters
+}
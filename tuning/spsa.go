@@ -0,0 +1,187 @@
+package tuning
+
+import (
+	"errors"
+	"math"
+	"math/rand"
+)
+
+// ...
+const (
+	DefaultAlpha = 0.602
+	DefaultGamma = 0.101
+)
+
+// ...
+var (
+	ErrInvalidParameter = errors.New("invalid parameter")
+)
+
+// Parameter ...
+type Parameter struct {
+	Name      string
+	Minimum   float64
+	Maximum   float64
+	Value     float64
+	IsInteger bool
+}
+
+// Objective ...
+//
+// It should compare two sets of parameter values and return a score
+// of the first set relative to the second one in the range [-1, 1].
+// The score can be noisy.
+//
+type Objective interface {
+	Compare(plus []float64, minus []float64) (float64, error)
+}
+
+// ObjectiveFunc ...
+type ObjectiveFunc func(plus []float64, minus []float64) (float64, error)
+
+// Compare ...
+func (objective ObjectiveFunc) Compare(
+	plus []float64,
+	minus []float64,
+) (float64, error) {
+	return objective(plus, minus)
+}
+
+// LogEntry ...
+//
+// It describes an iteration of the tuning. Values are after the iteration.
+//
+type LogEntry struct {
+	Iteration int
+	Plus      []float64
+	Minus     []float64
+	Score     float64
+	Values    []float64
+}
+
+// SPSA ...
+//
+// It implements the simultaneous perturbation stochastic approximation.
+// Parameters are tuned in normalized coordinates, where the range
+// of each parameter is mapped to [0, 1].
+//
+// The learning rate and the perturbation are decreased by iterations
+// with the exponents Alpha and Gamma correspondingly; zero exponents are
+// replaced by DefaultAlpha and DefaultGamma.
+//
+type SPSA struct {
+	IterationCount    int
+	LearningRate      float64
+	Perturbation      float64
+	Alpha             float64
+	Gamma             float64
+	StabilityConstant float64
+	Seed              int64
+	// It's optional.
+	Logger func(entry LogEntry)
+}
+
+// Tune ...
+//
+// It maximizes the objective and returns tuned parameters.
+//
+// Returned error can be ErrInvalidParameter or an error of the objective.
+//
+func (spsa SPSA) Tune(
+	parameters []Parameter,
+	objective Objective,
+) ([]Parameter, error) {
+	for _, parameter := range parameters {
+		if parameter.Minimum >= parameter.Maximum {
+			return nil, ErrInvalidParameter
+		}
+	}
+
+	alpha := spsa.Alpha
+	if alpha == 0 {
+		alpha = DefaultAlpha
+	}
+	gamma := spsa.Gamma
+	if gamma == 0 {
+		gamma = DefaultGamma
+	}
+
+	point := make([]float64, len(parameters))
+	for index, parameter := range parameters {
+		point[index] = normalize(parameter, parameter.Value)
+	}
+
+	randomizer := rand.New(rand.NewSource(spsa.Seed))
+	delta := make([]float64, len(parameters))
+	plus := make([]float64, len(parameters))
+	minus := make([]float64, len(parameters))
+	for iteration := 0; iteration < spsa.IterationCount; iteration++ {
+		k := float64(iteration + 1)
+		learningRate :=
+			spsa.LearningRate / math.Pow(k+spsa.StabilityConstant, alpha)
+		perturbation := spsa.Perturbation / math.Pow(k, gamma)
+
+		for index, parameter := range parameters {
+			delta[index] = 1
+			if randomizer.Intn(2) == 0 {
+				delta[index] = -1
+			}
+
+			shift := perturbation * delta[index]
+			plus[index] = denormalize(parameter, point[index]+shift)
+			minus[index] = denormalize(parameter, point[index]-shift)
+		}
+
+		score, err := objective.Compare(plus, minus)
+		if err != nil {
+			return nil, err
+		}
+
+		for index := range parameters {
+			gradient := score / (2 * perturbation * delta[index])
+			point[index] = clamp(point[index] + learningRate*gradient)
+		}
+
+		if spsa.Logger != nil {
+			values := make([]float64, len(parameters))
+			for index, parameter := range parameters {
+				values[index] = denormalize(parameter, point[index])
+			}
+
+			spsa.Logger(LogEntry{
+				Iteration: iteration,
+				Plus:      append([]float64(nil), plus...),
+				Minus:     append([]float64(nil), minus...),
+				Score:     score,
+				Values:    values,
+			})
+		}
+	}
+
+	tunedParameters := make([]Parameter, len(parameters))
+	for index, parameter := range parameters {
+		parameter.Value = denormalize(parameter, point[index])
+		tunedParameters[index] = parameter
+	}
+
+	return tunedParameters, nil
+}
+
+func normalize(parameter Parameter, value float64) float64 {
+	width := parameter.Maximum - parameter.Minimum
+	return clamp((value - parameter.Minimum) / width)
+}
+
+func denormalize(parameter Parameter, point float64) float64 {
+	width := parameter.Maximum - parameter.Minimum
+	value := parameter.Minimum + clamp(point)*width
+	if parameter.IsInteger {
+		value = math.Round(value)
+	}
+
+	return value
+}
+
+func clamp(point float64) float64 {
+	return math.Max(0, math.Min(point, 1))
+}
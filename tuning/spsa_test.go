@@ -0,0 +1,100 @@
+package tuning
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestSPSATune(test *testing.T) {
+	// the maximum is at the values 3 and 70
+	objective := ObjectiveFunc(func(
+		plus []float64,
+		minus []float64,
+	) (float64, error) {
+		value := func(values []float64) float64 {
+			first := (values[0] - 3) / 10
+			second := (values[1] - 70) / 100
+			return -(first*first + second*second)
+		}
+
+		return value(plus) - value(minus), nil
+	})
+
+	var logEntries []LogEntry
+	spsa := SPSA{
+		IterationCount:    1000,
+		LearningRate:      1,
+		Perturbation:      0.1,
+		StabilityConstant: 10,
+		Seed:              1,
+		Logger: func(entry LogEntry) {
+			logEntries = append(logEntries, entry)
+		},
+	}
+	parameters, err := spsa.Tune(
+		[]Parameter{
+			{Name: "first", Minimum: 0, Maximum: 10, Value: 8},
+			{Name: "second", Maximum: 100, Value: 20, IsInteger: true},
+		},
+		objective,
+	)
+
+	if len(parameters) != 2 ||
+		math.Abs(parameters[0].Value-3) > 0.1 ||
+		parameters[1].Value != 70 {
+		test.Fail()
+	}
+	if err != nil {
+		test.Fail()
+	}
+	if len(logEntries) != 1000 ||
+		logEntries[999].Iteration != 999 ||
+		!reflect.DeepEqual(logEntries[999].Values, []float64{
+			parameters[0].Value,
+			parameters[1].Value,
+		}) {
+		test.Fail()
+	}
+}
+
+func TestSPSATune_withError(test *testing.T) {
+	type data struct {
+		parameters []Parameter
+		objective  Objective
+		wantErr    error
+	}
+
+	for _, data := range []data{
+		{
+			parameters: []Parameter{{Name: "first", Minimum: 1, Maximum: 1}},
+			objective: ObjectiveFunc(func(
+				plus []float64,
+				minus []float64,
+			) (float64, error) {
+				panic("not implemented")
+			}),
+			wantErr: ErrInvalidParameter,
+		},
+		{
+			parameters: []Parameter{{Name: "first", Minimum: 0, Maximum: 1}},
+			objective: ObjectiveFunc(func(
+				plus []float64,
+				minus []float64,
+			) (float64, error) {
+				return 0, ErrInvalidParameter
+			}),
+			wantErr: ErrInvalidParameter,
+		},
+	} {
+		spsa := SPSA{IterationCount: 10, LearningRate: 1, Perturbation: 0.1}
+		parameters, err := spsa.Tune(data.parameters, data.objective)
+
+		if parameters != nil {
+			test.Fail()
+		}
+		if err != data.wantErr {
+			test.Fail()
+		}
+	}
+}
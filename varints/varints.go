@@ -0,0 +1,95 @@
+package varints
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"io"
+)
+
+// MaximalInt ...
+//
+// It's the maximal value of the int type.
+//
+const MaximalInt = int(^uint(0) >> 1)
+
+// ...
+var (
+	ErrInvalidData = errors.New("invalid data")
+)
+
+// Writer ...
+//
+// It writes raw data and varints to a buffered writer. After the first error,
+// next writes are skipped, so the error can be checked once at the end.
+//
+type Writer struct {
+	writer *bufio.Writer
+	buffer [binary.MaxVarintLen64]byte
+	err    error
+}
+
+// NewWriter ...
+func NewWriter(writer io.Writer) *Writer {
+	return &Writer{writer: bufio.NewWriter(writer)}
+}
+
+// Write ...
+func (writer *Writer) Write(data []byte) {
+	if writer.err != nil {
+		return
+	}
+
+	_, writer.err = writer.writer.Write(data)
+}
+
+// WriteUvarint ...
+func (writer *Writer) WriteUvarint(value uint64) {
+	length := binary.PutUvarint(writer.buffer[:], value)
+	writer.Write(writer.buffer[:length])
+}
+
+// WriteVarint ...
+func (writer *Writer) WriteVarint(value int64) {
+	length := binary.PutVarint(writer.buffer[:], value)
+	writer.Write(writer.buffer[:length])
+}
+
+// Err ...
+//
+// It returns the first error of writing, if any.
+//
+func (writer *Writer) Err() error {
+	return writer.err
+}
+
+// Flush ...
+//
+// It returns the first error of writing, if any; otherwise, it flushes
+// the buffered data.
+//
+func (writer *Writer) Flush() error {
+	if writer.err != nil {
+		return writer.err
+	}
+
+	return writer.writer.Flush()
+}
+
+// ReadInt ...
+//
+// It reads an unsigned varint that shouldn't exceed the maximum.
+//
+// Returned error can be ErrInvalidData or an error of the reader.
+//
+func ReadInt(reader io.ByteReader, maximum int) (int, error) {
+	value, err := binary.ReadUvarint(reader)
+	if err != nil {
+		return 0, err
+	}
+	if value > uint64(maximum) {
+		return 0, ErrInvalidData
+	}
+
+	return int(value), nil
+}
@@ -0,0 +1,97 @@
+package varints
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"reflect"
+	"testing"
+)
+
+type failingWriter struct {
+	count int
+}
+
+func (writer *failingWriter) Write(data []byte) (int, error) {
+	writer.count++
+	return 0, errors.New("dummy")
+}
+
+func TestWriter(test *testing.T) {
+	var buffer bytes.Buffer
+	writer := NewWriter(&buffer)
+	writer.Write([]byte("AB"))
+	writer.WriteUvarint(300)
+	writer.WriteVarint(-1)
+	err := writer.Flush()
+
+	want := []byte{'A', 'B', 0xac, 0x02, 0x01}
+	if !reflect.DeepEqual(buffer.Bytes(), want) {
+		test.Fail()
+	}
+	if writer.Err() != nil {
+		test.Fail()
+	}
+	if err != nil {
+		test.Fail()
+	}
+}
+
+func TestWriter_withError(test *testing.T) {
+	var innerWriter failingWriter
+	writer := NewWriter(&innerWriter)
+	// the data exceeds the buffer, so it's written through
+	writer.Write(make([]byte, 8192))
+	writer.WriteUvarint(1)
+	err := writer.Flush()
+
+	if innerWriter.count != 1 {
+		test.Fail()
+	}
+	if writer.Err() == nil || writer.Err().Error() != "dummy" {
+		test.Fail()
+	}
+	if err == nil || err.Error() != "dummy" {
+		test.Fail()
+	}
+}
+
+func TestReadInt(test *testing.T) {
+	type args struct {
+		data    []byte
+		maximum int
+	}
+	type data struct {
+		args    args
+		wantInt int
+		wantErr error
+	}
+
+	for _, data := range []data{
+		{
+			args:    args{data: []byte{0xac, 0x02}, maximum: 300},
+			wantInt: 300,
+			wantErr: nil,
+		},
+		{
+			args:    args{data: []byte{0xac, 0x02}, maximum: 299},
+			wantInt: 0,
+			wantErr: ErrInvalidData,
+		},
+		{
+			args:    args{data: nil, maximum: MaximalInt},
+			wantInt: 0,
+			wantErr: io.EOF,
+		},
+	} {
+		reader := bytes.NewReader(data.args.data)
+		got, gotErr := ReadInt(reader, data.args.maximum)
+
+		if got != data.wantInt {
+			test.Fail()
+		}
+		if gotErr != data.wantErr {
+			test.Fail()
+		}
+	}
+}
@@ -0,0 +1,140 @@
+package visualization
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/selectors"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+// DOTExporter ...
+//
+// It renders a tree in the DOT language of Graphviz.
+//
+// Nodes are labeled by their moves, win counts, game counts and win rates.
+// If the node scorer is set, edges are labeled by scores of their target nodes.
+//
+type DOTExporter struct {
+	// If it's zero, the depth isn't limited.
+	MaximalDepth int
+	// Children with a lesser game count are skipped; the root is always exported.
+	MinimalGameCount int
+	// If it's nil, edges aren't labeled.
+	NodeScorer selectors.NodeScorer
+	// If it's nil, the principal variation isn't highlighted.
+	PrincipalVariationSelector tree.NodeSelector
+	// If it's nil, no node is highlighted as chosen.
+	ChosenNode *tree.Node
+}
+
+// Export ...
+func (exporter DOTExporter) Export(writer io.Writer, root *tree.Node) error {
+	principalVariation := make(map[*tree.Node]struct{})
+	if exporter.PrincipalVariationSelector != nil {
+		nodes := root.PrincipalVariation(exporter.PrincipalVariationSelector)
+		for _, node := range nodes {
+			principalVariation[node] = struct{}{}
+		}
+	}
+
+	dotWriter := dotWriter{
+		exporter:           exporter,
+		writer:             bufio.NewWriter(writer),
+		principalVariation: principalVariation,
+	}
+	fmt.Fprintln(dotWriter.writer, "digraph tree {")
+	fmt.Fprintln(dotWriter.writer, "\tnode [shape=box];")
+	dotWriter.writeNode(root, 0)
+	fmt.Fprintln(dotWriter.writer, "}")
+
+	return dotWriter.writer.Flush()
+}
+
+type dotWriter struct {
+	exporter           DOTExporter
+	writer             *bufio.Writer
+	principalVariation map[*tree.Node]struct{}
+	nodeCount          int
+}
+
+func (writer *dotWriter) writeNode(node *tree.Node, depth int) (id int) {
+	id = writer.nodeCount
+	writer.nodeCount++
+
+	attributes := []string{"label=\"" + nodeLabel(node) + "\""}
+	if node == writer.exporter.ChosenNode {
+		attributes = append(attributes, "style=filled", "fillcolor=lightblue")
+	}
+	if _, ok := writer.principalVariation[node]; ok {
+		attributes = append(attributes, "color=red", "penwidth=2")
+	}
+	writer.writeStatement(fmt.Sprintf("n%d", id), attributes)
+
+	maximalDepth := writer.exporter.MaximalDepth
+	if maximalDepth != 0 && depth >= maximalDepth {
+		return id
+	}
+
+	for _, child := range node.Children {
+		if child.State.GameCount < writer.exporter.MinimalGameCount {
+			continue
+		}
+
+		childID := writer.writeNode(child, depth+1)
+
+		var attributes []string
+		if writer.exporter.NodeScorer != nil {
+			score := writer.exporter.NodeScorer.ScoreNode(child)
+			label := strconv.FormatFloat(score, 'f', 3, 64)
+			attributes = append(attributes, "label=\""+label+"\"")
+		}
+		if _, ok := writer.principalVariation[child]; ok {
+			attributes = append(attributes, "color=red", "penwidth=2")
+		}
+		writer.writeStatement(fmt.Sprintf("n%d -> n%d", id, childID), attributes)
+	}
+
+	return id
+}
+
+func (writer *dotWriter) writeStatement(statement string, attributes []string) {
+	if len(attributes) != 0 {
+		statement += " [" + strings.Join(attributes, ", ") + "]"
+	}
+
+	fmt.Fprintf(writer.writer, "\t%s;\n", statement)
+}
+
+func nodeLabel(node *tree.Node) string {
+	var color string
+	switch node.Move.Color {
+	case models.Black:
+		color = "B"
+	case models.White:
+		color = "W"
+	}
+
+	point := "-"
+	if node.Move.Point != models.NilPoint {
+		point = fmt.Sprintf("%d:%d", node.Move.Point.Column, node.Move.Point.Row)
+	}
+
+	winRate := "-"
+	if node.State.GameCount != 0 {
+		winRate = strconv.FormatFloat(node.State.WinRate()*100, 'f', 1, 64) + "%"
+	}
+
+	return fmt.Sprintf(
+		"%s %s\\n%d/%d (%s)",
+		color,
+		point,
+		node.State.WinCount,
+		node.State.GameCount,
+		winRate,
+	)
+}
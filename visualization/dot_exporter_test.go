@@ -0,0 +1,142 @@
+package visualization
+
+import (
+	"bytes"
+	"testing"
+
+	models "github.com/thewizardplusplus/go-atari-models"
+	"github.com/thewizardplusplus/go-atari-montecarlo/selectors"
+	"github.com/thewizardplusplus/go-atari-montecarlo/selectors/scorers"
+	"github.com/thewizardplusplus/go-atari-montecarlo/tree"
+)
+
+func TestDOTExporterExport(test *testing.T) {
+	type fields struct {
+		maximalDepth               int
+		minimalGameCount           int
+		nodeScorer                 selectors.NodeScorer
+		principalVariationSelector tree.NodeSelector
+		chosenNode                 func(root *tree.Node) *tree.Node
+	}
+	type data struct {
+		fields fields
+		want   string
+	}
+
+	for _, data := range []data{
+		{
+			fields: fields{},
+			want: "digraph tree {\n" +
+				"\tnode [shape=box];\n" +
+				"\tn0 [label=\"W -\\n6/10 (60.0%)\"];\n" +
+				"\tn1 [label=\"B 0:0\\n1/4 (25.0%)\"];\n" +
+				"\tn2 [label=\"W 1:0\\n0/0 (-)\"];\n" +
+				"\tn1 -> n2;\n" +
+				"\tn0 -> n1;\n" +
+				"\tn3 [label=\"B 1:1\\n3/6 (50.0%)\"];\n" +
+				"\tn0 -> n3;\n" +
+				"}\n",
+		},
+		{
+			fields: fields{
+				maximalDepth:     1,
+				minimalGameCount: 5,
+			},
+			want: "digraph tree {\n" +
+				"\tnode [shape=box];\n" +
+				"\tn0 [label=\"W -\\n6/10 (60.0%)\"];\n" +
+				"\tn1 [label=\"B 1:1\\n3/6 (50.0%)\"];\n" +
+				"\tn0 -> n1;\n" +
+				"}\n",
+		},
+		{
+			fields: fields{
+				maximalDepth: 1,
+				nodeScorer:   scorers.GameCountScorer{},
+				principalVariationSelector: selectors.MaximalNodeSelector{
+					NodeScorer: scorers.GameCountScorer{},
+				},
+				chosenNode: func(root *tree.Node) *tree.Node {
+					return root.Children[0]
+				},
+			},
+			want: "digraph tree {\n" +
+				"\tnode [shape=box];\n" +
+				"\tn0 [label=\"W -\\n6/10 (60.0%)\"];\n" +
+				"\tn1 [label=\"B 0:0\\n1/4 (25.0%)\", " +
+				"style=filled, fillcolor=lightblue];\n" +
+				"\tn0 -> n1 [label=\"4.000\"];\n" +
+				"\tn2 [label=\"B 1:1\\n3/6 (50.0%)\", color=red, penwidth=2];\n" +
+				"\tn0 -> n2 [label=\"6.000\", color=red, penwidth=2];\n" +
+				"}\n",
+		},
+	} {
+		root := newTestTree()
+
+		var chosenNode *tree.Node
+		if data.fields.chosenNode != nil {
+			chosenNode = data.fields.chosenNode(root)
+		}
+
+		exporter := DOTExporter{
+			MaximalDepth:               data.fields.maximalDepth,
+			MinimalGameCount:           data.fields.minimalGameCount,
+			NodeScorer:                 data.fields.nodeScorer,
+			PrincipalVariationSelector: data.fields.principalVariationSelector,
+			ChosenNode:                 chosenNode,
+		}
+
+		var buffer bytes.Buffer
+		err := exporter.Export(&buffer, root)
+
+		if got := buffer.String(); got != data.want {
+			test.Fail()
+		}
+		if err != nil {
+			test.Fail()
+		}
+	}
+}
+
+func newTestTree() *tree.Node {
+	root := &tree.Node{
+		Move: models.NewPreliminaryMove(models.Black),
+		State: tree.NodeState{
+			GameCount: 10,
+			WinCount:  6,
+		},
+	}
+	childOne := &tree.Node{
+		Parent: root,
+		Move: models.Move{
+			Color: models.Black,
+			Point: models.Point{Column: 0, Row: 0},
+		},
+		State: tree.NodeState{
+			GameCount: 4,
+			WinCount:  1,
+		},
+	}
+	childOneOne := &tree.Node{
+		Parent: childOne,
+		Move: models.Move{
+			Color: models.White,
+			Point: models.Point{Column: 1, Row: 0},
+		},
+	}
+	childTwo := &tree.Node{
+		Parent: root,
+		Move: models.Move{
+			Color: models.Black,
+			Point: models.Point{Column: 1, Row: 1},
+		},
+		State: tree.NodeState{
+			GameCount: 6,
+			WinCount:  3,
+		},
+	}
+	root.Children = tree.NodeGroup{childOne, childTwo}
+	childOne.Children = tree.NodeGroup{childOneOne}
+
+	return root
+}